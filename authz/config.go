@@ -0,0 +1,41 @@
+package authz
+
+import "errors"
+
+const (
+	// DefaultJwtKey is the jwt_key of the sample configs. It is public, so
+	// signing or accepting HS256 tokens with it is refused.
+	DefaultJwtKey = "super-secret-jwt-key-change-in-production"
+
+	DefaultJwksURL = "http://localhost:7979/.well-known/jwks.json"
+)
+
+var ErrDefaultJwtKey = errors.New("jwt_key must be set and not default while accept_hs256 is on")
+
+// VerifierConfig is how a service checks the tokens of the user service.
+// JwksURL points at its public keys; AcceptHS256 keeps tokens signed with
+// JwtKey valid, as the user service signs them until it has keys of its own.
+type VerifierConfig struct {
+	JwtKey      string `yaml:"jwt_key" mapstructure:"jwt_key"`
+	JwksURL     string `yaml:"jwks_url" mapstructure:"jwks_url"`
+	AcceptHS256 bool   `yaml:"accept_hs256" mapstructure:"accept_hs256"`
+}
+
+// Validate refuses a shared secret anybody could sign tokens with.
+func (c VerifierConfig) Validate() error {
+	if c.AcceptHS256 && (c.JwtKey == "" || c.JwtKey == DefaultJwtKey) {
+		return ErrDefaultJwtKey
+	}
+
+	return nil
+}
+
+// HS256Secret is the shared secret tokens may be signed with, nil once
+// HS256 is no longer accepted.
+func (c VerifierConfig) HS256Secret() []byte {
+	if !c.AcceptHS256 {
+		return nil
+	}
+
+	return []byte(c.JwtKey)
+}
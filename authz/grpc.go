@@ -0,0 +1,73 @@
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MetadataKey  = "authorization"
+	BearerPrefix = "Bearer "
+)
+
+// Authenticated marks a method that needs a caller but no extra permission.
+const Authenticated Permission = ""
+
+// Policy maps full gRPC method names to the permission they require.
+// Methods missing from the policy are served without authentication.
+type Policy map[string]Permission
+
+// UnaryServerInterceptor authenticates the bearer token forwarded by the
+// gateway and enforces the policy before the handler runs.
+func UnaryServerInterceptor(verifier *Verifier, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if raw, ok := tokenFromMetadata(ctx); ok {
+			claims, err := verifier.ParseAccessToken(raw)
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, ErrInvalidToken.Error())
+			}
+
+			ctx = NewContext(ctx, claims)
+		}
+
+		perm, guarded := policy[info.FullMethod]
+		if !guarded {
+			return handler(ctx, req)
+		}
+
+		claims, ok := FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		if perm != Authenticated && !claims.Role.Can(perm) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// OutgoingContext forwards an authorization header to downstream services.
+func OutgoingContext(ctx context.Context, header string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, header)
+}
+
+func tokenFromMetadata(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], BearerPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(values[0], BearerPrefix), true
+}
@@ -0,0 +1,247 @@
+package authz
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWKSPath is where the user service publishes its public keys.
+const JWKSPath = "/.well-known/jwks.json"
+
+const (
+	// DefaultJWKSTTL is how long a fetched JWKS document is trusted.
+	DefaultJWKSTTL = 10 * time.Minute
+
+	jwksFetchTimeout = 5 * time.Second
+
+	// unknown kids trigger a refetch, at most this often
+	jwksMinRefresh = 30 * time.Second
+
+	maxJWKSSize = 1 << 20
+)
+
+var ErrJWKSUnavailable = errors.New("jwks unavailable")
+
+// JWK is a public key in RFC 7517 form.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// OKP (Ed25519) and EC (P-256)
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func NewJWK(kid string, pub crypto.PublicKey) (JWK, error) {
+	enc := base64.RawURLEncoding
+
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			N:   enc.EncodeToString(key.N.Bytes()),
+			E:   enc.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+			Crv: "Ed25519",
+			X:   enc.EncodeToString(key),
+		}, nil
+	default:
+		return JWK{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, pub)
+	}
+}
+
+func (j JWK) PublicKey() (crypto.PublicKey, error) {
+	enc := base64.RawURLEncoding
+
+	switch j.Kty {
+	case "RSA":
+		n, err := enc.DecodeString(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwk %s: %w", j.Kid, err)
+		}
+
+		e, err := enc.DecodeString(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwk %s: %w", j.Kid, err)
+		}
+
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid jwk %s: exponent out of range", j.Kid)
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, j.Crv)
+		}
+
+		x, err := enc.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid jwk %s", j.Kid)
+		}
+
+		return ed25519.PublicKey(x), nil
+	case "EC":
+		// only verified here, the user service signs with RSA or Ed25519
+		if j.Crv != "P-256" {
+			return nil, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, j.Crv)
+		}
+
+		x, errX := enc.DecodeString(j.X)
+		y, errY := enc.DecodeString(j.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			return nil, fmt.Errorf("invalid jwk %s", j.Kid)
+		}
+
+		// ecdh rejects points that are not on the curve
+		point := append(append([]byte{4}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("invalid jwk %s: %w", j.Kid, err)
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: kty %s", ErrUnsupportedKey, j.Kty)
+	}
+}
+
+// RemoteKeySet verifies tokens with keys fetched from a JWKS endpoint. The
+// document is cached for ttl and fetched again early when a token names an
+// unknown kid, which is how rotated keys are picked up.
+type RemoteKeySet struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	document    []byte
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func NewRemoteKeySet(url string, ttl time.Duration) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+}
+
+func (r *RemoteKeySet) PublicKey(kid string) (crypto.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[kid]
+
+	stale := time.Since(r.fetchedAt) > r.ttl
+	if (stale || !ok) && time.Since(r.lastAttempt) > jwksMinRefresh {
+		// a failed refresh keeps serving the keys fetched before
+		if err := r.refresh(); err == nil {
+			key, ok = r.keys[kid]
+		}
+	}
+
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+// Document returns the cached JWKS document, fetching it if needed.
+func (r *RemoteKeySet) Document() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if (r.document == nil || time.Since(r.fetchedAt) > r.ttl) && time.Since(r.lastAttempt) > jwksMinRefresh {
+		_ = r.refresh()
+	}
+
+	if r.document == nil {
+		return nil, ErrJWKSUnavailable
+	}
+
+	return r.document, nil
+}
+
+func (r *RemoteKeySet) refresh() error {
+	r.lastAttempt = time.Now()
+
+	resp, err := r.client.Get(r.url)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrJWKSUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d", ErrJWKSUnavailable, resp.StatusCode)
+	}
+
+	document, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrJWKSUnavailable, err)
+	}
+
+	var set JWKS
+	if err = json.Unmarshal(document, &set); err != nil {
+		return fmt.Errorf("%w: %v", ErrJWKSUnavailable, err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+
+	for _, jwk := range set.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	r.keys = keys
+	r.document = document
+	r.fetchedAt = time.Now()
+
+	return nil
+}
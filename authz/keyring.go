@@ -0,0 +1,184 @@
+package authz
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSABits = 2048
+
+var (
+	ErrNoActiveKey       = errors.New("active signing key not found")
+	ErrUnsupportedKey    = errors.New("unsupported private key type")
+	ErrNoSigningMaterial = errors.New("no signing key and no shared secret")
+)
+
+// SigningKey is a private key of the user service named by its kid.
+type SigningKey struct {
+	ID     string
+	Method jwt.SigningMethod
+	key    crypto.Signer
+}
+
+// KeyRing signs tokens with the active key and publishes the public half of
+// every key it holds, so tokens signed before a rotation stay verifiable
+// until retired keys are removed.
+type KeyRing struct {
+	active *SigningKey
+	keys   map[string]*SigningKey
+	secret []byte
+}
+
+// NewHMACKeyRing signs with the shared HS256 secret only.
+func NewHMACKeyRing(secret []byte) *KeyRing {
+	return &KeyRing{
+		keys:   map[string]*SigningKey{},
+		secret: secret,
+	}
+}
+
+// LoadKeyRing reads every <kid>.pem in dir. PKCS#8 and PKCS#1 RSA keys sign
+// with RS256, Ed25519 keys with EdDSA. An empty dir falls back to HS256.
+// secret, if set, keeps HS256 tokens verifiable during a migration.
+func LoadKeyRing(dir, activeKid string, secret []byte) (*KeyRing, error) {
+	ring := NewHMACKeyRing(secret)
+
+	if dir == "" {
+		if len(secret) == 0 {
+			return nil, ErrNoSigningMaterial
+		}
+
+		return ring, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed list keys: %w", err)
+	}
+
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+
+		key, err := loadSigningKey(kid, path)
+		if err != nil {
+			return nil, err
+		}
+
+		ring.keys[kid] = key
+	}
+
+	active, ok := ring.keys[activeKid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q in %s", ErrNoActiveKey, activeKid, dir)
+	}
+
+	ring.active = active
+
+	return ring, nil
+}
+
+func loadSigningKey(kid, path string) (*SigningKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed read key %s: %w", kid, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed decode key %s: no pem block", kid)
+	}
+
+	var parsed any
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: %s in %s", ErrUnsupportedKey, block.Type, kid)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed parse key %s: %w", kid, err)
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("%w: rsa key %s is shorter than %d bits", ErrUnsupportedKey, kid, minRSABits)
+		}
+
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodRS256, key: key}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodEdDSA, key: key}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T in %s", ErrUnsupportedKey, parsed, kid)
+	}
+}
+
+// Sign signs the claims with the active key, or with HS256 when the ring
+// has no asymmetric keys.
+func (k *KeyRing) Sign(claims jwt.Claims) (string, error) {
+	if k.active == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(k.secret)
+	}
+
+	token := jwt.NewWithClaims(k.active.Method, claims)
+	token.Header["kid"] = k.active.ID
+
+	return token.SignedString(k.active.key)
+}
+
+func (k *KeyRing) PublicKey(kid string) (crypto.PublicKey, error) {
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key.key.Public(), nil
+}
+
+// JWKS returns the public keys of the ring, sorted by kid.
+func (k *KeyRing) JWKS() (*JWKS, error) {
+	kids := make([]string, 0, len(k.keys))
+	for kid := range k.keys {
+		kids = append(kids, kid)
+	}
+
+	sort.Strings(kids)
+
+	set := &JWKS{Keys: make([]JWK, 0, len(kids))}
+
+	for _, kid := range kids {
+		jwk, err := NewJWK(kid, k.keys[kid].key.Public())
+		if err != nil {
+			return nil, err
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set, nil
+}
+
+// Verifier verifies tokens signed by any key of the ring and, while the
+// secret is kept, HS256 tokens.
+func (k *KeyRing) Verifier() *Verifier {
+	if len(k.keys) == 0 {
+		return NewVerifier(k.secret, nil)
+	}
+
+	return NewVerifier(k.secret, k)
+}
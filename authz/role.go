@@ -0,0 +1,50 @@
+// Package authz holds roles, permissions and access token verification
+// shared by the gateway and the gRPC services.
+package authz
+
+import "errors"
+
+type (
+	Role       string
+	Permission string
+)
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+const (
+	PermDeleteAnyReview Permission = "reviews:delete:any"
+	PermModerateReviews Permission = "reviews:moderate"
+	PermManageUsers     Permission = "users:manage"
+	PermManageCatalog   Permission = "catalog:manage"
+)
+
+var ErrUnknownRole = errors.New("unknown role")
+
+var grants = map[Role][]Permission{
+	RoleUser:      {},
+	RoleModerator: {PermDeleteAnyReview, PermModerateReviews},
+	RoleAdmin:     {PermDeleteAnyReview, PermModerateReviews, PermManageUsers, PermManageCatalog},
+}
+
+func ParseRole(s string) (Role, error) {
+	role := Role(s)
+	if _, ok := grants[role]; !ok {
+		return "", ErrUnknownRole
+	}
+
+	return role, nil
+}
+
+func (r Role) Can(perm Permission) bool {
+	for _, p := range grants[r] {
+		if p == perm {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+package authz
+
+import (
+	"errors"
+	"slices"
+)
+
+type Scope string
+
+// Scopes limit what a personal access token may do on behalf of its owner.
+// They never grant more than the owner's role allows.
+const (
+	ScopeProfileRead  Scope = "profile:read"
+	ScopeProfileWrite Scope = "profile:write"
+	ScopeReviewsRead  Scope = "reviews:read"
+	ScopeReviewsWrite Scope = "reviews:write"
+	ScopeCatalogRead  Scope = "catalog:read"
+	ScopeUsersAdmin   Scope = "users:admin"
+)
+
+// PersonalTokenPrefix starts every personal access token, which tells them
+// apart from JWTs in an Authorization header.
+const PersonalTokenPrefix = "mmpat_"
+
+var ErrUnknownScope = errors.New("unknown scope")
+
+var scopes = []Scope{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeReviewsRead,
+	ScopeReviewsWrite,
+	ScopeCatalogRead,
+	ScopeUsersAdmin,
+}
+
+func ParseScope(s string) (Scope, error) {
+	scope := Scope(s)
+	if !slices.Contains(scopes, scope) {
+		return "", ErrUnknownScope
+	}
+
+	return scope, nil
+}
+
+// Allows reports whether the caller may act within scope. Session tokens
+// are not scoped.
+func (c *Claims) Allows(scope Scope) bool {
+	return !c.Personal || slices.Contains(c.Scopes, scope)
+}
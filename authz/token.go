@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid access token")
+
+// Claims identify the caller of a request.
+type Claims struct {
+	UserID    string
+	SessionID string
+	Role      Role
+
+	EmailVerified bool
+
+	// Personal is set for tokens exchanged for a personal access token,
+	// which act only within Scopes. SessionID is the token id then.
+	Personal bool
+	Scopes   []Scope
+}
+
+type claimsKey struct{}
+
+// ParseAccessToken verifies an access token issued by the user service.
+func (v *Verifier) ParseAccessToken(raw string) (*Claims, error) {
+	claims, err := v.Parse(raw, jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+
+	// refresh tokens carry no "ref" claim and must not be accepted here
+	ref, ok := claims["ref"].(string)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	uid, ok := claims["uid"].(string)
+	if !ok || uid == "" {
+		return nil, ErrInvalidToken
+	}
+
+	// tokens issued before roles existed belong to regular users
+	role := RoleUser
+	if raw, ok := claims["role"].(string); ok {
+		if role, err = ParseRole(raw); err != nil {
+			return nil, ErrInvalidToken
+		}
+	}
+
+	// a missing claim counts as unverified
+	verified, _ := claims["email_verified"].(bool)
+
+	personal, _ := claims["pat"].(bool)
+
+	var scopes []Scope
+
+	if personal {
+		raw, _ := claims["scp"].([]any)
+
+		for _, s := range raw {
+			// unknown scopes are dropped, which only narrows the token
+			if scope, err := ParseScope(fmt.Sprint(s)); err == nil {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return &Claims{
+		UserID:    uid,
+		SessionID: ref,
+		Role:      role,
+
+		EmailVerified: verified,
+
+		Personal: personal,
+		Scopes:   scopes,
+	}, nil
+}
+
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+
+	return claims, ok
+}
@@ -0,0 +1,99 @@
+package authz
+
+import (
+	"crypto"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// PublicKeys resolves the key named by the kid header of a token.
+type PublicKeys interface {
+	PublicKey(kid string) (crypto.PublicKey, error)
+}
+
+// Verifier checks token signatures. RS256 and EdDSA tokens are verified
+// with the key their kid names; HS256 tokens only while a shared secret is
+// configured, which is meant for the migration away from it.
+type Verifier struct {
+	secret []byte
+	keys   PublicKeys
+}
+
+// NewVerifier accepts HS256 tokens when secret is not empty and
+// asymmetric ones when keys is not nil.
+func NewVerifier(secret []byte, keys PublicKeys) *Verifier {
+	if len(secret) == 0 {
+		secret = nil
+	}
+
+	return &Verifier{
+		secret: secret,
+		keys:   keys,
+	}
+}
+
+// Methods lists the accepted signing algorithms.
+func (v *Verifier) Methods() []string {
+	var methods []string
+
+	if v.keys != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg())
+	}
+
+	if v.secret != nil {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	return methods
+}
+
+// Keyfunc is a jwt.Keyfunc; use it together with Methods.
+func (v *Verifier) Keyfunc(t *jwt.Token) (any, error) {
+	if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+		if v.secret == nil {
+			return nil, ErrUnknownKey
+		}
+
+		return v.secret, nil
+	}
+
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" || v.keys == nil {
+		return nil, ErrUnknownKey
+	}
+
+	return v.keys.PublicKey(kid)
+}
+
+// Parse verifies the signature and expiry of any token of the user service.
+func (v *Verifier) Parse(raw string, opts ...jwt.ParserOption) (jwt.MapClaims, error) {
+	opts = append(opts, jwt.WithValidMethods(v.Methods()))
+
+	token, err := jwt.Parse(raw, v.Keyfunc, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// NewRemoteVerifier verifies tokens of the user service without calling
+// it: asymmetric tokens with the keys published at jwksURL, HS256 tokens
+// with secret. Either may be empty.
+func NewRemoteVerifier(jwksURL string, secret []byte) (*Verifier, *RemoteKeySet) {
+	if jwksURL == "" {
+		return NewVerifier(secret, nil), nil
+	}
+
+	keys := NewRemoteKeySet(jwksURL, DefaultJWKSTTL)
+
+	return NewVerifier(secret, keys), keys
+}
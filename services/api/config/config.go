@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -13,6 +15,20 @@ type Config struct {
 	MarkServiceAddr  string `yaml:"mark_service_addr" mapstructure:"mark_service_addr"`
 	MusicServiceAddr string `yaml:"music_service_addr" mapstructure:"music_service_addr"`
 	UserServiceAddr  string `yaml:"user_service_addr" mapstructure:"user_service_addr"`
+
+	authz.VerifierConfig `yaml:",inline" mapstructure:",squash"`
+
+	// TrustedProxies are the CIDR ranges of the proxies in front of the
+	// gateway. Client addresses are read from X-Forwarded-For only when the
+	// request comes through one of them, and from the connection otherwise.
+	TrustedProxies []string `yaml:"trusted_proxies" mapstructure:"trusted_proxies"`
+
+	Cookie CookieConfig `yaml:"cookie" mapstructure:"cookie"`
+}
+
+type CookieConfig struct {
+	Secure          bool          `yaml:"secure" mapstructure:"secure"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" mapstructure:"refresh_token_ttl"`
 }
 
 func NewConfig(path string, logger *logger.Logger) (*Config, error) {
@@ -25,6 +41,12 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.SetDefault("mark_service_addr", "localhost:50053")
 	v.SetDefault("music_service_addr", "localhost:50052")
 	v.SetDefault("user_service_addr", "localhost:50051")
+	v.SetDefault("jwt_key", authz.DefaultJwtKey)
+	v.SetDefault("jwks_url", authz.DefaultJwksURL)
+	v.SetDefault("accept_hs256", true)
+
+	v.SetDefault("cookie.secure", true)
+	v.SetDefault("cookie.refresh_token_ttl", 72*time.Hour)
 
 	v.SetEnvPrefix("APP")
 	v.AutomaticEnv()
@@ -44,11 +66,22 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.BindEnv("user_service_addr", "APP_USER_SERVICE_ADDR")
 	v.BindEnv("music_service_addr", "APP_MUSIC_SERVICE_ADDR")
 	v.BindEnv("mark_service_addr", "APP_MARK_SERVICE_ADDR")
+	v.BindEnv("jwt_key", "APP_JWT_KEY")
+	v.BindEnv("jwks_url", "APP_JWKS_URL")
+	v.BindEnv("accept_hs256", "APP_ACCEPT_HS256")
+	v.BindEnv("trusted_proxies", "APP_TRUSTED_PROXIES")
+
+	v.BindEnv("cookie.secure", "APP_COOKIE_SECURE")
+	v.BindEnv("cookie.refresh_token_ttl", "APP_COOKIE_REFRESH_TOKEN_TTL")
 
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("failed unmarshal config: %w", err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &cfg, nil
 }
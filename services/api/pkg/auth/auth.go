@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/api/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrMissingToken    = errors.New("missing access token")
+	ErrInvalidToken    = errors.New("invalid access token")
+	ErrForbidden       = errors.New("permission denied")
+	ErrSessionRequired = errors.New("personal access tokens cannot be used here")
+)
+
+const (
+	// exchanged personal tokens are reused for at most this long, which
+	// bounds how long a revoked token keeps working
+	personalTokenCacheTTL = time.Minute
+
+	maxCachedPersonalTokens = 10000
+)
+
+// PersonalTokens trades personal access tokens for scoped access tokens.
+type PersonalTokens interface {
+	ExchangePersonalToken(ctx context.Context, token string) (string, time.Time, error)
+}
+
+type exchangedToken struct {
+	access string
+	until  time.Time
+}
+
+type Auth struct {
+	verifier *authz.Verifier
+	jwks     *authz.RemoteKeySet
+	logger   *logger.Logger
+
+	pats      PersonalTokens
+	mu        sync.Mutex
+	exchanged map[string]exchangedToken
+}
+
+func NewAuth(cfg *config.Config, logger *logger.Logger) *Auth {
+	verifier, jwks := authz.NewRemoteVerifier(cfg.JwksURL, cfg.HS256Secret())
+
+	return &Auth{
+		verifier: verifier,
+		jwks:     jwks,
+		logger:   logger,
+
+		exchanged: map[string]exchangedToken{},
+	}
+}
+
+// UsePersonalTokens makes Middleware accept personal access tokens, which
+// are rejected until then.
+func (a *Auth) UsePersonalTokens(pats PersonalTokens) {
+	a.pats = pats
+}
+
+// Middleware rejects requests without a valid access token issued by the
+// user service, stores the caller on the request context and forwards the
+// token to the gRPC services. Personal access tokens are exchanged for an
+// access token first, which is forwarded in their place.
+func (a *Auth) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return a.authenticate(c, next, true)
+	}
+}
+
+// Optional is Middleware for public routes: anonymous requests pass, but a
+// presented token must be valid so that RequireScope can check it.
+func (a *Auth) Optional(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return a.authenticate(c, next, false)
+	}
+}
+
+func (a *Auth) authenticate(c echo.Context, next echo.HandlerFunc, required bool) error {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(header, authz.BearerPrefix) {
+		if !required && header == "" {
+			return next(c)
+		}
+
+		return c.String(http.StatusUnauthorized, ErrMissingToken.Error())
+	}
+
+	raw := strings.TrimPrefix(header, authz.BearerPrefix)
+
+	if strings.HasPrefix(raw, authz.PersonalTokenPrefix) {
+		access, err := a.exchange(c.Request().Context(), raw)
+		if err != nil {
+			if errors.Is(err, ErrInvalidToken) {
+				return c.String(http.StatusUnauthorized, ErrInvalidToken.Error())
+			}
+
+			return c.String(http.StatusInternalServerError, "failed authenticate personal access token")
+		}
+
+		raw = access
+		header = authz.BearerPrefix + access
+	}
+
+	claims, err := a.verifier.ParseAccessToken(raw)
+	if err != nil {
+		a.logger.Warn("rejected access token",
+			zap.String("path", c.Path()),
+			zap.Error(err))
+
+		return c.String(http.StatusUnauthorized, ErrInvalidToken.Error())
+	}
+
+	ctx := authz.NewContext(c.Request().Context(), claims)
+	ctx = authz.OutgoingContext(ctx, header)
+	c.SetRequest(c.Request().WithContext(ctx))
+
+	return next(c)
+}
+
+// exchange returns the access token for a personal token, asking the user
+// service only when no cached one is left.
+func (a *Auth) exchange(ctx context.Context, raw string) (string, error) {
+	if a.pats == nil {
+		return "", ErrInvalidToken
+	}
+
+	sum := sha256.Sum256([]byte(raw))
+	key := hex.EncodeToString(sum[:])
+	now := time.Now()
+
+	a.mu.Lock()
+	cached, ok := a.exchanged[key]
+	a.mu.Unlock()
+
+	if ok && now.Before(cached.until) {
+		return cached.access, nil
+	}
+
+	access, exp, err := a.pats.ExchangePersonalToken(ctx, raw)
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return "", ErrInvalidToken
+		}
+
+		a.logger.Error("failed exchange personal token",
+			zap.Error(err))
+
+		return "", err
+	}
+
+	until := now.Add(personalTokenCacheTTL)
+	if exp.Before(until) {
+		until = exp
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.exchanged) >= maxCachedPersonalTokens {
+		for k, v := range a.exchanged {
+			if !now.Before(v.until) {
+				delete(a.exchanged, k)
+			}
+		}
+
+		// still full of live entries: start over rather than grow
+		if len(a.exchanged) >= maxCachedPersonalTokens {
+			clear(a.exchanged)
+		}
+	}
+
+	a.exchanged[key] = exchangedToken{
+		access: access,
+		until:  until,
+	}
+
+	return access, nil
+}
+
+// Require must run after Middleware and rejects callers whose role lacks
+// the permission.
+func (a *Auth) Require(perm authz.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims, ok := authz.FromContext(c.Request().Context())
+			if !ok {
+				return c.String(http.StatusUnauthorized, ErrMissingToken.Error())
+			}
+
+			if !claims.Role.Can(perm) {
+				a.logger.Warn("permission denied",
+					zap.String("user_id", claims.UserID),
+					zap.String("role", string(claims.Role)),
+					zap.String("permission", string(perm)))
+
+				return c.String(http.StatusForbidden, ErrForbidden.Error())
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireScope rejects personal access tokens without the scope. Callers
+// without a token are left to Middleware, so it also fits public routes
+// behind Optional.
+func (a *Auth) RequireScope(scope authz.Scope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims, ok := authz.FromContext(c.Request().Context())
+			if ok && !claims.Allows(scope) {
+				return c.String(http.StatusForbidden, "token lacks scope "+string(scope))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireSession must run after Middleware and rejects personal access
+// tokens, for routes that change credentials or the account itself.
+func (a *Auth) RequireSession(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		claims, ok := authz.FromContext(c.Request().Context())
+		if !ok {
+			return c.String(http.StatusUnauthorized, ErrMissingToken.Error())
+		}
+
+		if claims.Personal {
+			return c.String(http.StatusForbidden, ErrSessionRequired.Error())
+		}
+
+		return next(c)
+	}
+}
+
+// JWKS republishes the public keys of the user service, which is not
+// reachable from outside.
+func (a *Auth) JWKS(c echo.Context) error {
+	if a.jwks == nil {
+		return c.String(http.StatusNotFound, "jwks is not configured")
+	}
+
+	document, err := a.jwks.Document()
+	if err != nil {
+		a.logger.Error("failed fetch jwks",
+			zap.Error(err))
+
+		return c.String(http.StatusServiceUnavailable, "failed fetch jwks")
+	}
+
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, document)
+}
+
+// UserIDFromContext returns the user authenticated by Middleware.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	return claims.UserID, true
+}
+
+// UserID is a shorthand for UserIDFromContext on an echo request.
+func UserID(c echo.Context) string {
+	uid, _ := UserIDFromContext(c.Request().Context())
+
+	return uid
+}
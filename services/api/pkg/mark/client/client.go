@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
@@ -25,33 +26,27 @@ func NewMarkClient(cc pb.MarkServiceClient, logger *logger.Logger) *MarkClient {
 	}
 }
 
-func (u *MarkClient) GetReviews(ctx context.Context, releaseID string) ([]entity.Review, error) {
-	if releaseID == "" {
-		return nil, ErrNilInput
+func (u *MarkClient) GetReviews(ctx context.Context, req *pb.GetReviewsRequest) ([]entity.Review, string, error) {
+	if req == nil || req.ReleaseId == "" {
+		return nil, "", ErrNilInput
 	}
 
-	resp, err := u.cc.GetReviews(ctx, &pb.GetReviewsRequest{ReleaseId: releaseID})
+	resp, err := u.cc.GetReviews(ctx, req)
 	if err != nil {
 		u.logger.Error("failed fetch reviews",
-			zap.String("release_id", releaseID),
+			zap.Any("req", req),
 			zap.Error(err))
 
-		return nil, fmt.Errorf("failed fetch reviews: %w", err)
+		return nil, "", fmt.Errorf("failed fetch reviews: %w", err)
 	}
 
 	reviews := make([]entity.Review, len(resp.Reviews))
 
 	for i, review := range resp.Reviews {
-		reviews[i] = entity.Review{
-			ID:        uint(review.Id),
-			UserID:    review.UserId,
-			Text:      review.Text,
-			Count:     int(review.Count),
-			ReleaseID: review.ReleaseId,
-		}
+		reviews[i] = *reviewFromProto(review)
 	}
 
-	return reviews, nil
+	return reviews, resp.NextPageToken, nil
 }
 
 func (u *MarkClient) DeleteReview(ctx context.Context, id uint) error {
@@ -84,9 +79,116 @@ func (u *MarkClient) GetMark(ctx context.Context, releaseID string) (*entity.Mar
 		return nil, fmt.Errorf("failed fetch mark: %w", err)
 	}
 
-	mark := entity.NewMark(pbmark.ReleaseId, pbmark.Value)
+	return markFromProto(pbmark), nil
+}
+
+func (u *MarkClient) GetReleaseGroupMark(ctx context.Context, releaseGroupID string) (*entity.Mark, error) {
+	if releaseGroupID == "" {
+		return nil, ErrNilInput
+	}
+
+	pbmark, err := u.cc.GetReleaseGroupMark(ctx, &pb.GetReleaseGroupMarkRequest{ReleaseGroupId: releaseGroupID})
+	if err != nil {
+		u.logger.Error("failed fetch release group mark",
+			zap.String("release_group_id", releaseGroupID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch release group mark: %w", err)
+	}
+
+	return markFromProto(pbmark), nil
+}
+
+func (u *MarkClient) GetArtistMark(ctx context.Context, artistID string) (*entity.Mark, error) {
+	if artistID == "" {
+		return nil, ErrNilInput
+	}
+
+	pbmark, err := u.cc.GetArtistMark(ctx, &pb.GetArtistMarkRequest{ArtistId: artistID})
+	if err != nil {
+		u.logger.Error("failed fetch artist mark",
+			zap.String("artist_id", artistID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch artist mark: %w", err)
+	}
+
+	return markFromProto(pbmark), nil
+}
+
+func (u *MarkClient) ListMarks(ctx context.Context, req *pb.ListMarksRequest) ([]entity.Mark, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.ListMarks(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch marks",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch marks: %w", err)
+	}
+
+	marks := make([]entity.Mark, len(resp.Marks))
+	for i, mark := range resp.Marks {
+		marks[i] = *markFromProto(mark)
+	}
+
+	return marks, resp.NextPageToken, nil
+}
+
+func (u *MarkClient) GetChart(ctx context.Context, req *pb.GetChartRequest) (*entity.ChartPage, error) {
+	if req == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.GetChart(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch chart",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch chart: %w", err)
+	}
+
+	page := &entity.ChartPage{
+		Entries:       make([]entity.ChartEntry, len(resp.Entries)),
+		NextPageToken: resp.NextPageToken,
+	}
+
+	for i, entry := range resp.Entries {
+		page.Entries[i] = entity.ChartEntry{
+			Rank:      int(entry.Rank),
+			Position:  int(entry.Position),
+			ReleaseID: entry.ReleaseId,
+			Weighted:  entry.Weighted,
+			Value:     entry.Value,
+			Reviews:   int(entry.Reviews),
+		}
+	}
+
+	if resp.ComputedAt != nil {
+		page.ComputedAt = resp.ComputedAt.AsTime()
+	}
 
-	return mark, nil
+	return page, nil
+}
+
+func markFromProto(pbmark *pb.Mark) *entity.Mark {
+	mark := &entity.Mark{
+		ID:        uint(pbmark.Id),
+		ReleaseID: pbmark.ReleaseId,
+		Value:     pbmark.Value,
+		Reviews:   int(pbmark.Reviews),
+		Median:    pbmark.Median,
+		StdDev:    pbmark.StdDev,
+		Weighted:  pbmark.Weighted,
+	}
+
+	copy(mark.Histogram[:], pbmark.Histogram)
+
+	return mark
 }
 
 func (u *MarkClient) CreateReview(ctx context.Context, review *entity.Review) error {
@@ -105,3 +207,694 @@ func (u *MarkClient) CreateReview(ctx context.Context, review *entity.Review) er
 
 	return nil
 }
+
+func (u *MarkClient) UpdateReview(ctx context.Context, id uint, text, language string, count int) (*entity.Review, error) {
+	resp, err := u.cc.UpdateReview(ctx, &pb.UpdateReviewRequest{
+		Id:       uint64(id),
+		Text:     text,
+		Count:    int32(count),
+		Language: language,
+	})
+	if err != nil {
+		u.logger.Error("failed update review",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed update review: %w", err)
+	}
+
+	return reviewFromProto(resp), nil
+}
+
+func (u *MarkClient) Rate(ctx context.Context, releaseID string, score int) (*entity.Review, error) {
+	resp, err := u.cc.Rate(ctx, &pb.RateRequest{
+		ReleaseId: releaseID,
+		Score:     int32(score),
+	})
+	if err != nil {
+		u.logger.Error("failed rate release",
+			zap.String("release_id", releaseID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed rate release: %w", err)
+	}
+
+	return reviewFromProto(resp), nil
+}
+
+func (u *MarkClient) ListReviewRevisions(ctx context.Context, reviewID uint) ([]entity.ReviewRevision, error) {
+	resp, err := u.cc.ListReviewRevisions(ctx, &pb.ListReviewRevisionsRequest{ReviewId: uint64(reviewID)})
+	if err != nil {
+		u.logger.Error("failed fetch review revisions",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch review revisions: %w", err)
+	}
+
+	revisions := make([]entity.ReviewRevision, len(resp.Revisions))
+
+	for i, revision := range resp.Revisions {
+		revisions[i] = entity.ReviewRevision{
+			ID:        uint(revision.Id),
+			ReviewID:  uint(revision.ReviewId),
+			Text:      revision.Text,
+			Count:     int(revision.Count),
+			WrittenAt: revision.WrittenAt.AsTime(),
+			CreatedAt: revision.ReplacedAt.AsTime(),
+		}
+	}
+
+	return revisions, nil
+}
+
+func reviewFromProto(review *pb.Review) *entity.Review {
+	converted := &entity.Review{
+		ID:        uint(review.Id),
+		UserID:    review.UserId,
+		Text:      review.Text,
+		Count:     int(review.Count),
+		Likes:     review.Likes,
+		Comments:  int(review.Comments),
+		ReleaseID: review.ReleaseId,
+		Language:  review.Language,
+	}
+
+	if review.EditedAt != nil {
+		editedAt := review.EditedAt.AsTime()
+		converted.EditedAt = &editedAt
+	}
+
+	if review.HiddenAt != nil {
+		hiddenAt := review.HiddenAt.AsTime()
+		converted.HiddenAt = &hiddenAt
+	}
+
+	return converted
+}
+
+func (u *MarkClient) LikeReview(ctx context.Context, reviewID uint) (int64, error) {
+	resp, err := u.cc.LikeReview(ctx, &pb.LikeReviewRequest{ReviewId: uint64(reviewID)})
+	if err != nil {
+		u.logger.Error("failed like review",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return 0, fmt.Errorf("failed like review: %w", err)
+	}
+
+	return resp.Likes, nil
+}
+
+func (u *MarkClient) UnlikeReview(ctx context.Context, reviewID uint) (int64, error) {
+	resp, err := u.cc.UnlikeReview(ctx, &pb.UnlikeReviewRequest{ReviewId: uint64(reviewID)})
+	if err != nil {
+		u.logger.Error("failed unlike review",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return 0, fmt.Errorf("failed unlike review: %w", err)
+	}
+
+	return resp.Likes, nil
+}
+
+func (u *MarkClient) ListReviewLikers(ctx context.Context, req *pb.ListReviewLikersRequest) ([]entity.ReviewLike, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.ListReviewLikers(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch review likers",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch review likers: %w", err)
+	}
+
+	likes := make([]entity.ReviewLike, len(resp.Likers))
+
+	for i, like := range resp.Likers {
+		likes[i] = entity.ReviewLike{
+			ReviewID:  uint(like.ReviewId),
+			UserID:    like.UserId,
+			CreatedAt: like.CreatedAt.AsTime(),
+		}
+	}
+
+	return likes, resp.NextPageToken, nil
+}
+
+func (u *MarkClient) CreateComment(ctx context.Context, reviewID, parentID uint, text string) (*entity.Comment, error) {
+	resp, err := u.cc.CreateComment(ctx, &pb.CreateCommentRequest{
+		ReviewId: uint64(reviewID),
+		ParentId: uint64(parentID),
+		Text:     text,
+	})
+	if err != nil {
+		u.logger.Error("failed create comment",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed create comment: %w", err)
+	}
+
+	return commentFromProto(resp), nil
+}
+
+func (u *MarkClient) EditComment(ctx context.Context, id uint, text string) (*entity.Comment, error) {
+	resp, err := u.cc.EditComment(ctx, &pb.EditCommentRequest{
+		Id:   uint64(id),
+		Text: text,
+	})
+	if err != nil {
+		u.logger.Error("failed edit comment",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed edit comment: %w", err)
+	}
+
+	return commentFromProto(resp), nil
+}
+
+func (u *MarkClient) DeleteComment(ctx context.Context, id uint) error {
+	_, err := u.cc.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: uint64(id)})
+	if err != nil {
+		u.logger.Error("failed delete comment",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete comment: %w", err)
+	}
+
+	return nil
+}
+
+func (u *MarkClient) ListComments(ctx context.Context, req *pb.ListCommentsRequest) ([]entity.Comment, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.ListComments(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch comments",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch comments: %w", err)
+	}
+
+	comments := make([]entity.Comment, len(resp.Comments))
+
+	for i, comment := range resp.Comments {
+		comments[i] = *commentFromProto(comment)
+	}
+
+	return comments, resp.NextPageToken, nil
+}
+
+func commentFromProto(comment *pb.Comment) *entity.Comment {
+	converted := &entity.Comment{
+		ID:        uint(comment.Id),
+		ReviewID:  uint(comment.ReviewId),
+		UserID:    comment.UserId,
+		Text:      comment.Text,
+		Depth:     int(comment.Depth),
+		Replies:   int(comment.Replies),
+		Deleted:   comment.Deleted,
+		CreatedAt: comment.CreatedAt.AsTime(),
+	}
+
+	if comment.ParentId != 0 {
+		parentID := uint(comment.ParentId)
+		converted.ParentID = &parentID
+	}
+
+	if comment.EditedAt != nil {
+		editedAt := comment.EditedAt.AsTime()
+		converted.EditedAt = &editedAt
+	}
+
+	return converted
+}
+
+func (u *MarkClient) ReportReview(ctx context.Context, reviewID uint, reason, details string) error {
+	_, err := u.cc.ReportReview(ctx, &pb.ReportReviewRequest{
+		ReviewId: uint64(reviewID),
+		Reason:   reason,
+		Details:  details,
+	})
+	if err != nil {
+		u.logger.Error("failed report review",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed report review: %w", err)
+	}
+
+	return nil
+}
+
+func (u *MarkClient) ListModerationQueue(ctx context.Context, pageSize int, pageToken string) ([]entity.ModerationItem, string, error) {
+	resp, err := u.cc.ListModerationQueue(ctx, &pb.ListModerationQueueRequest{
+		PageSize:  int32(pageSize),
+		PageToken: pageToken,
+	})
+	if err != nil {
+		u.logger.Error("failed fetch moderation queue",
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch moderation queue: %w", err)
+	}
+
+	items := make([]entity.ModerationItem, len(resp.Items))
+
+	for i, item := range resp.Items {
+		reasons := make(map[entity.ReportReason]int64, len(item.Reasons))
+		for reason, count := range item.Reasons {
+			reasons[entity.ReportReason(reason)] = count
+		}
+
+		items[i] = entity.ModerationItem{
+			Review:        *reviewFromProto(item.Review),
+			Reports:       item.Reports,
+			Reasons:       reasons,
+			FirstReported: item.FirstReportedAt.AsTime(),
+		}
+	}
+
+	return items, resp.NextPageToken, nil
+}
+
+func (u *MarkClient) HideReview(ctx context.Context, reviewID uint, note string) (*entity.Review, error) {
+	resp, err := u.cc.HideReview(ctx, &pb.ModerateReviewRequest{
+		ReviewId: uint64(reviewID),
+		Note:     note,
+	})
+	if err != nil {
+		u.logger.Error("failed hide review",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed hide review: %w", err)
+	}
+
+	return reviewFromProto(resp), nil
+}
+
+func (u *MarkClient) RestoreReview(ctx context.Context, reviewID uint, note string) (*entity.Review, error) {
+	resp, err := u.cc.RestoreReview(ctx, &pb.ModerateReviewRequest{
+		ReviewId: uint64(reviewID),
+		Note:     note,
+	})
+	if err != nil {
+		u.logger.Error("failed restore review",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed restore review: %w", err)
+	}
+
+	return reviewFromProto(resp), nil
+}
+
+func (u *MarkClient) ResolveReports(ctx context.Context, reviewID uint, note string) (int64, error) {
+	resp, err := u.cc.ResolveReports(ctx, &pb.ModerateReviewRequest{
+		ReviewId: uint64(reviewID),
+		Note:     note,
+	})
+	if err != nil {
+		u.logger.Error("failed resolve reports",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return 0, fmt.Errorf("failed resolve reports: %w", err)
+	}
+
+	return resp.Resolved, nil
+}
+
+func (u *MarkClient) CreateDiaryEntry(ctx context.Context, req *pb.CreateDiaryEntryRequest) (*entity.DiaryEntry, error) {
+	if req == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.CreateDiaryEntry(ctx, req)
+	if err != nil {
+		u.logger.Error("failed create diary entry",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed create diary entry: %w", err)
+	}
+
+	return diaryEntryFromProto(resp), nil
+}
+
+func (u *MarkClient) EditDiaryEntry(ctx context.Context, req *pb.EditDiaryEntryRequest) (*entity.DiaryEntry, error) {
+	if req == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.EditDiaryEntry(ctx, req)
+	if err != nil {
+		u.logger.Error("failed edit diary entry",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed edit diary entry: %w", err)
+	}
+
+	return diaryEntryFromProto(resp), nil
+}
+
+func (u *MarkClient) DeleteDiaryEntry(ctx context.Context, id uint) error {
+	_, err := u.cc.DeleteDiaryEntry(ctx, &pb.DeleteDiaryEntryRequest{Id: uint64(id)})
+	if err != nil {
+		u.logger.Error("failed delete diary entry",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete diary entry: %w", err)
+	}
+
+	return nil
+}
+
+func (u *MarkClient) ListDiary(ctx context.Context, req *pb.ListDiaryRequest) ([]entity.DiaryEntry, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.ListDiary(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch diary",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch diary: %w", err)
+	}
+
+	entries := make([]entity.DiaryEntry, len(resp.Entries))
+
+	for i, entry := range resp.Entries {
+		entries[i] = *diaryEntryFromProto(entry)
+	}
+
+	return entries, resp.NextPageToken, nil
+}
+
+func (u *MarkClient) GetDiaryStats(ctx context.Context, userID string, year int) (*entity.DiaryStats, error) {
+	resp, err := u.cc.GetDiaryStats(ctx, &pb.GetDiaryStatsRequest{
+		UserId: userID,
+		Year:   int32(year),
+	})
+	if err != nil {
+		u.logger.Error("failed fetch diary stats",
+			zap.String("user_id", userID),
+			zap.Int("year", year),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch diary stats: %w", err)
+	}
+
+	stats := &entity.DiaryStats{
+		Year:       int(resp.Year),
+		Entries:    resp.Entries,
+		Releases:   resp.Releases,
+		Relistens:  resp.Relistens,
+		TopArtists: make([]entity.ArtistListens, len(resp.TopArtists)),
+	}
+
+	if resp.AverageScore != nil {
+		average := float64(*resp.AverageScore)
+		stats.AverageScore = &average
+	}
+
+	for i, artist := range resp.TopArtists {
+		stats.TopArtists[i] = entity.ArtistListens{
+			ArtistID: artist.ArtistId,
+			Entries:  artist.Entries,
+		}
+	}
+
+	return stats, nil
+}
+
+func diaryEntryFromProto(entry *pb.DiaryEntry) *entity.DiaryEntry {
+	converted := &entity.DiaryEntry{
+		ID:        uint(entry.Id),
+		UserID:    entry.UserId,
+		ReleaseID: entry.ReleaseId,
+		Relisten:  entry.Relisten,
+		CreatedAt: entry.CreatedAt.AsTime(),
+	}
+
+	// the service only sends dates it wrote
+	converted.ListenedOn, _ = time.Parse(entity.DateLayout, entry.ListenedOn)
+
+	if entry.Score != nil {
+		score := int(*entry.Score)
+		converted.Score = &score
+	}
+
+	if entry.ReviewId != 0 {
+		reviewID := uint(entry.ReviewId)
+		converted.ReviewID = &reviewID
+	}
+
+	if entry.EditedAt != nil {
+		editedAt := entry.EditedAt.AsTime()
+		converted.EditedAt = &editedAt
+	}
+
+	return converted
+}
+
+func (u *MarkClient) CreateList(ctx context.Context, req *pb.CreateListRequest) (*entity.List, error) {
+	if req == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.CreateList(ctx, req)
+	if err != nil {
+		u.logger.Error("failed create list",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed create list: %w", err)
+	}
+
+	return listFromProto(resp), nil
+}
+
+func (u *MarkClient) UpdateList(ctx context.Context, req *pb.UpdateListRequest) (*entity.List, error) {
+	if req == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.UpdateList(ctx, req)
+	if err != nil {
+		u.logger.Error("failed update list",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed update list: %w", err)
+	}
+
+	return listFromProto(resp), nil
+}
+
+func (u *MarkClient) DeleteList(ctx context.Context, id uint) error {
+	_, err := u.cc.DeleteList(ctx, &pb.DeleteListRequest{Id: uint64(id)})
+	if err != nil {
+		u.logger.Error("failed delete list",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete list: %w", err)
+	}
+
+	return nil
+}
+
+func (u *MarkClient) GetList(ctx context.Context, id uint) (*entity.List, error) {
+	resp, err := u.cc.GetList(ctx, &pb.GetListRequest{Id: uint64(id)})
+	if err != nil {
+		u.logger.Error("failed fetch list",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch list: %w", err)
+	}
+
+	return listFromProto(resp), nil
+}
+
+func (u *MarkClient) BrowseLists(ctx context.Context, req *pb.BrowseListsRequest) ([]entity.List, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.BrowseLists(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch lists",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch lists: %w", err)
+	}
+
+	lists := make([]entity.List, len(resp.Lists))
+
+	for i, list := range resp.Lists {
+		lists[i] = *listFromProto(list)
+	}
+
+	return lists, resp.NextPageToken, nil
+}
+
+func (u *MarkClient) AddListItem(ctx context.Context, req *pb.AddListItemRequest) (*entity.ListItem, error) {
+	if req == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.AddListItem(ctx, req)
+	if err != nil {
+		u.logger.Error("failed add list item",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed add list item: %w", err)
+	}
+
+	return listItemFromProto(resp), nil
+}
+
+func (u *MarkClient) EditListItem(ctx context.Context, id uint, note string) (*entity.ListItem, error) {
+	resp, err := u.cc.EditListItem(ctx, &pb.EditListItemRequest{
+		Id:   uint64(id),
+		Note: note,
+	})
+	if err != nil {
+		u.logger.Error("failed edit list item",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed edit list item: %w", err)
+	}
+
+	return listItemFromProto(resp), nil
+}
+
+func (u *MarkClient) MoveListItem(ctx context.Context, id uint, position int) (*entity.ListItem, error) {
+	resp, err := u.cc.MoveListItem(ctx, &pb.MoveListItemRequest{
+		Id:       uint64(id),
+		Position: int32(position),
+	})
+	if err != nil {
+		u.logger.Error("failed move list item",
+			zap.Uint("id", id),
+			zap.Int("position", position),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed move list item: %w", err)
+	}
+
+	return listItemFromProto(resp), nil
+}
+
+func (u *MarkClient) RemoveListItem(ctx context.Context, id uint) error {
+	_, err := u.cc.RemoveListItem(ctx, &pb.RemoveListItemRequest{Id: uint64(id)})
+	if err != nil {
+		u.logger.Error("failed remove list item",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return fmt.Errorf("failed remove list item: %w", err)
+	}
+
+	return nil
+}
+
+func (u *MarkClient) GetListItems(ctx context.Context, req *pb.GetListItemsRequest) ([]entity.ListItem, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.GetListItems(ctx, req)
+	if err != nil {
+		u.logger.Error("failed fetch list items",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed fetch list items: %w", err)
+	}
+
+	items := make([]entity.ListItem, len(resp.Items))
+
+	for i, item := range resp.Items {
+		items[i] = *listItemFromProto(item)
+	}
+
+	return items, resp.NextPageToken, nil
+}
+
+func (u *MarkClient) LikeList(ctx context.Context, listID uint) (int64, error) {
+	resp, err := u.cc.LikeList(ctx, &pb.LikeListRequest{ListId: uint64(listID)})
+	if err != nil {
+		u.logger.Error("failed like list",
+			zap.Uint("list_id", listID),
+			zap.Error(err))
+
+		return 0, fmt.Errorf("failed like list: %w", err)
+	}
+
+	return resp.Likes, nil
+}
+
+func (u *MarkClient) UnlikeList(ctx context.Context, listID uint) (int64, error) {
+	resp, err := u.cc.UnlikeList(ctx, &pb.UnlikeListRequest{ListId: uint64(listID)})
+	if err != nil {
+		u.logger.Error("failed unlike list",
+			zap.Uint("list_id", listID),
+			zap.Error(err))
+
+		return 0, fmt.Errorf("failed unlike list: %w", err)
+	}
+
+	return resp.Likes, nil
+}
+
+func listFromProto(list *pb.List) *entity.List {
+	converted := &entity.List{
+		ID:          uint(list.Id),
+		UserID:      list.UserId,
+		Name:        list.Name,
+		Description: list.Description,
+		Public:      list.Public,
+		Items:       int(list.Items),
+		Likes:       list.Likes,
+		CreatedAt:   list.CreatedAt.AsTime(),
+	}
+
+	if list.EditedAt != nil {
+		editedAt := list.EditedAt.AsTime()
+		converted.EditedAt = &editedAt
+	}
+
+	return converted
+}
+
+func listItemFromProto(item *pb.ListItem) *entity.ListItem {
+	return &entity.ListItem{
+		ID:        uint(item.Id),
+		ListID:    uint(item.ListId),
+		ReleaseID: item.ReleaseId,
+		Position:  int(item.Position),
+		Note:      item.Note,
+		CreatedAt: item.CreatedAt.AsTime(),
+	}
+}
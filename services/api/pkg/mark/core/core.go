@@ -4,21 +4,25 @@ import (
 	"fmt"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/api/config"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/mark/client"
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/mark/handler"
 	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type MarkCore struct {
 	handler *handler.Handler
+	auth    *auth.Auth
 }
 
-func SetupMarkCore(cfg *config.Config, logger *logger.Logger) (*MarkCore, error) {
-	conn, err := grpc.NewClient(cfg.MarkServiceAddr)
+func SetupMarkCore(cfg *config.Config, auth *auth.Auth, logger *logger.Logger) (*MarkCore, error) {
+	conn, err := grpc.NewClient(cfg.MarkServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		logger.Error("failed connect to mark service",
 			zap.String("addr", cfg.MarkServiceAddr),
@@ -34,14 +38,67 @@ func SetupMarkCore(cfg *config.Config, logger *logger.Logger) (*MarkCore, error)
 
 	return &MarkCore{
 		handler: handler,
+		auth:    auth,
 	}, nil
 }
 
 func (m *MarkCore) RegisterHandler(e *echo.Echo) {
-	e.GET("/reviews/:releaseid", m.handler.GetReviews)
-	e.GET("/mark/:releaseid", m.handler.GetMark)
+	read := m.auth.RequireScope(authz.ScopeReviewsRead)
+	write := m.auth.RequireScope(authz.ScopeReviewsWrite)
 
-	e.POST("/review/create", m.handler.CreateReview)
+	e.GET("/reviews/:releaseid", m.handler.GetReviews, m.auth.Optional, read)
+	e.GET("/mark/:releaseid", m.handler.GetMark, m.auth.Optional, read)
+	e.GET("/marks", m.handler.ListMarks, m.auth.Optional, read)
+	e.GET("/v1/release-groups/:id/mark", m.handler.GetReleaseGroupMark, m.auth.Optional, read)
+	e.GET("/v1/artists/:id/mark", m.handler.GetArtistMark, m.auth.Optional, read)
+	e.GET("/v1/charts", m.handler.GetChart, m.auth.Optional, read)
 
-	e.DELETE("/review/delete", m.handler.DeleteReview)
+	e.GET("/review/revisions/:id", m.handler.ListReviewRevisions, m.auth.Optional, read)
+	e.GET("/review/likers/:id", m.handler.ListReviewLikers, m.auth.Optional, read)
+
+	e.POST("/review/create", m.handler.CreateReview, m.auth.Middleware, write)
+
+	e.PUT("/review/update/:id", m.handler.UpdateReview, m.auth.Middleware, write)
+	e.PUT("/v1/releases/:releaseid/rating", m.handler.Rate, m.auth.Middleware, write)
+
+	e.POST("/review/like/:id", m.handler.LikeReview, m.auth.Middleware, write)
+	e.DELETE("/review/like/:id", m.handler.UnlikeReview, m.auth.Middleware, write)
+
+	comments := e.Group("/v1/reviews/:id/comments")
+	comments.GET("", m.handler.ListComments, m.auth.Optional, read)
+	comments.POST("", m.handler.CreateComment, m.auth.Middleware, write)
+	comments.PATCH("/:comment", m.handler.EditComment, m.auth.Middleware, write)
+	comments.DELETE("/:comment", m.handler.DeleteComment, m.auth.Middleware, write)
+
+	e.DELETE("/review/delete/:id", m.handler.DeleteReview, m.auth.Middleware, write)
+
+	e.POST("/v1/reviews/:id/reports", m.handler.ReportReview, m.auth.Middleware, write)
+
+	e.GET("/v1/users/:id/diary", m.handler.ListDiary, m.auth.Optional, read)
+	e.GET("/v1/users/:id/diary/stats", m.handler.GetDiaryStats, m.auth.Optional, read)
+
+	diary := e.Group("/v1/diary", m.auth.Middleware, write)
+	diary.POST("", m.handler.CreateDiaryEntry)
+	diary.PATCH("/:id", m.handler.EditDiaryEntry)
+	diary.DELETE("/:id", m.handler.DeleteDiaryEntry)
+
+	lists := e.Group("/v1/lists")
+	lists.GET("", m.handler.BrowseLists, m.auth.Optional, read)
+	lists.GET("/:id", m.handler.GetList, m.auth.Optional, read)
+	lists.GET("/:id/items", m.handler.GetListItems, m.auth.Optional, read)
+	lists.POST("", m.handler.CreateList, m.auth.Middleware, write)
+	lists.PATCH("/:id", m.handler.UpdateList, m.auth.Middleware, write)
+	lists.DELETE("/:id", m.handler.DeleteList, m.auth.Middleware, write)
+	lists.POST("/:id/items", m.handler.AddListItem, m.auth.Middleware, write)
+	lists.PATCH("/:id/items/:item", m.handler.EditListItem, m.auth.Middleware, write)
+	lists.PUT("/:id/items/:item/position", m.handler.MoveListItem, m.auth.Middleware, write)
+	lists.DELETE("/:id/items/:item", m.handler.RemoveListItem, m.auth.Middleware, write)
+	lists.POST("/:id/like", m.handler.LikeList, m.auth.Middleware, write)
+	lists.DELETE("/:id/like", m.handler.UnlikeList, m.auth.Middleware, write)
+
+	moderation := e.Group("/v1/moderation", m.auth.Middleware, write, m.auth.Require(authz.PermModerateReviews))
+	moderation.GET("/queue", m.handler.ListModerationQueue)
+	moderation.POST("/reviews/:id/hide", m.handler.HideReview)
+	moderation.POST("/reviews/:id/restore", m.handler.RestoreReview)
+	moderation.POST("/reviews/:id/resolve", m.handler.ResolveReports)
 }
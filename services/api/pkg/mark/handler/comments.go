@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func commentError(c echo.Context, err error, action string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return c.String(http.StatusBadRequest, statusMessage(err))
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, "review or comment not found")
+	case codes.PermissionDenied:
+		return c.String(http.StatusForbidden, "only the author or a moderator can "+action+" this comment")
+	}
+
+	return c.String(http.StatusInternalServerError, "failed "+action+" comment "+err.Error())
+}
+
+func (h *Handler) CreateComment(c echo.Context) error {
+	reviewID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var req struct {
+		ParentID uint   `json:"parent_id"`
+		Text     string `json:"text"`
+	}
+
+	if err = c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind comment")
+	}
+
+	comment, err := h.cc.CreateComment(c.Request().Context(), uint(reviewID), req.ParentID, req.Text)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return c.String(http.StatusForbidden, "verify your email before posting comments")
+		}
+
+		return commentError(c, err, "create")
+	}
+
+	return c.JSON(http.StatusCreated, comment)
+}
+
+func (h *Handler) EditComment(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("comment"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var req struct {
+		Text string `json:"text"`
+	}
+
+	if err = c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind comment")
+	}
+
+	comment, err := h.cc.EditComment(c.Request().Context(), uint(id), req.Text)
+	if err != nil {
+		return commentError(c, err, "edit")
+	}
+
+	return c.JSON(http.StatusOK, comment)
+}
+
+func (h *Handler) DeleteComment(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("comment"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	if err = h.cc.DeleteComment(c.Request().Context(), uint(id)); err != nil {
+		return commentError(c, err, "delete")
+	}
+
+	return c.String(http.StatusOK, "deleted successfully")
+}
+
+func (h *Handler) ListComments(c echo.Context) error {
+	reviewID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	req := &pb.ListCommentsRequest{
+		ReviewId:  uint64(reviewID),
+		PageToken: c.QueryParam("page_token"),
+	}
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		req.PageSize = int32(size)
+	}
+
+	if raw := c.QueryParam("parent_id"); raw != "" {
+		parentID, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert parent_id")
+		}
+
+		req.ParentId = uint64(parentID)
+	}
+
+	if raw := c.QueryParam("depth"); raw != "" {
+		depth, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert depth")
+		}
+
+		value := int32(depth)
+		req.Depth = &value
+	}
+
+	comments, next, err := h.cc.ListComments(c.Request().Context(), req)
+	if err != nil {
+		return commentError(c, err, "list")
+	}
+
+	msg := struct {
+		Comments      []entity.Comment `json:"comments"`
+		NextPageToken string           `json:"next_page_token,omitempty"`
+	}{
+		Comments:      comments,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
@@ -4,7 +4,10 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) CreateReview(c echo.Context) error {
@@ -14,7 +17,18 @@ func (h *Handler) CreateReview(c echo.Context) error {
 		return c.String(http.StatusBadRequest, "faield bind review")
 	}
 
+	review.UserID = auth.UserID(c)
+
 	if err := h.cc.CreateReview(c.Request().Context(), &review); err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			return c.String(http.StatusForbidden, "verify your email before posting reviews")
+		case codes.AlreadyExists:
+			return c.String(http.StatusConflict, "release is already reviewed, edit the review instead")
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
 		return c.String(http.StatusInternalServerError, "failed create review "+err.Error())
 	}
 
@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) DeleteReview(c echo.Context) error {
@@ -16,6 +18,10 @@ func (h *Handler) DeleteReview(c echo.Context) error {
 	}
 
 	if err = h.cc.DeleteReview(c.Request().Context(), uint(id)); err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return c.String(http.StatusForbidden, "only the author or a moderator can delete this review")
+		}
+
 		return c.String(http.StatusInternalServerError, "faield delete review "+err.Error())
 	}
 
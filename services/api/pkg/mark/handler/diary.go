@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func diaryError(c echo.Context, err error, action string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, statusMessage(err))
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, "diary entry not found")
+	case codes.PermissionDenied:
+		return c.String(http.StatusForbidden, "only the author can "+action+" this diary entry")
+	}
+
+	return c.String(http.StatusInternalServerError, "failed "+action+" diary entry "+err.Error())
+}
+
+// diaryEntryBody is the JSON body of a new or edited entry; ListenedOn is
+// like "2025-03-14" and today when empty.
+type diaryEntryBody struct {
+	ReleaseID  string `json:"release_id"`
+	ListenedOn string `json:"listened_on"`
+	Score      *int32 `json:"score"`
+	ReviewID   uint   `json:"review_id"`
+	Relisten   bool   `json:"relisten"`
+}
+
+// queryInt reads an optional integer query parameter, 0 when it is unset.
+func queryInt(c echo.Context, name string) (int, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(raw)
+}
+
+func (h *Handler) CreateDiaryEntry(c echo.Context) error {
+	var body diaryEntryBody
+
+	if err := c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind diary entry")
+	}
+
+	entry, err := h.cc.CreateDiaryEntry(c.Request().Context(), &pb.CreateDiaryEntryRequest{
+		ReleaseId:  body.ReleaseID,
+		ListenedOn: body.ListenedOn,
+		Score:      body.Score,
+		ReviewId:   uint64(body.ReviewID),
+		Relisten:   body.Relisten,
+	})
+	if err != nil {
+		return diaryError(c, err, "create")
+	}
+
+	return c.JSON(http.StatusCreated, entry)
+}
+
+// EditDiaryEntry replaces the date, score, review and relisten flag of an
+// entry; the release of the body is ignored.
+func (h *Handler) EditDiaryEntry(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var body diaryEntryBody
+
+	if err = c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind diary entry")
+	}
+
+	entry, err := h.cc.EditDiaryEntry(c.Request().Context(), &pb.EditDiaryEntryRequest{
+		Id:         uint64(id),
+		ListenedOn: body.ListenedOn,
+		Score:      body.Score,
+		ReviewId:   uint64(body.ReviewID),
+		Relisten:   body.Relisten,
+	})
+	if err != nil {
+		return diaryError(c, err, "edit")
+	}
+
+	return c.JSON(http.StatusOK, entry)
+}
+
+func (h *Handler) DeleteDiaryEntry(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	if err = h.cc.DeleteDiaryEntry(c.Request().Context(), uint(id)); err != nil {
+		return diaryError(c, err, "delete")
+	}
+
+	return c.String(http.StatusOK, "deleted successfully")
+}
+
+// ListDiary pages through a user's diary, for example
+// /v1/users/:id/diary?year=2025&month=3.
+func (h *Handler) ListDiary(c echo.Context) error {
+	year, err := queryInt(c, "year")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert year")
+	}
+
+	month, err := queryInt(c, "month")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert month")
+	}
+
+	size, err := queryInt(c, "page_size")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert page size")
+	}
+
+	entries, next, err := h.cc.ListDiary(c.Request().Context(), &pb.ListDiaryRequest{
+		UserId:    c.Param("id"),
+		Year:      int32(year),
+		Month:     int32(month),
+		PageSize:  int32(size),
+		PageToken: c.QueryParam("page_token"),
+	})
+	if err != nil {
+		return diaryError(c, err, "list")
+	}
+
+	msg := struct {
+		Entries       []entity.DiaryEntry `json:"entries"`
+		NextPageToken string              `json:"next_page_token,omitempty"`
+	}{
+		Entries:       entries,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) GetDiaryStats(c echo.Context) error {
+	year, err := queryInt(c, "year")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert year")
+	}
+
+	stats, err := h.cc.GetDiaryStats(c.Request().Context(), c.Param("id"), year)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
+		return c.String(http.StatusInternalServerError, "failed get diary stats "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
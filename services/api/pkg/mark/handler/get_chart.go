@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetChart pages through a chart of releases, for example
+// /v1/charts?period=year&year=2024&genre=rock or /v1/charts?period=week.
+func (h *Handler) GetChart(c echo.Context) error {
+	req := &pb.GetChartRequest{
+		Period:      c.QueryParam("period"),
+		Genre:       c.QueryParam("genre"),
+		Country:     c.QueryParam("country"),
+		PrimaryType: c.QueryParam("type"),
+		PageToken:   c.QueryParam("page_token"),
+	}
+
+	if raw := c.QueryParam("year"); raw != "" {
+		year, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert year")
+		}
+
+		req.Year = int32(year)
+	}
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		req.PageSize = int32(size)
+	}
+
+	page, err := h.cc.GetChart(c.Request().Context(), req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
+		return c.String(http.StatusInternalServerError, "failed get chart "+err.Error())
+	}
+
+	msg := struct {
+		Entries       []entity.ChartEntry `json:"entries"`
+		ComputedAt    *time.Time          `json:"computed_at,omitempty"`
+		NextPageToken string              `json:"next_page_token,omitempty"`
+	}{
+		Entries:       page.Entries,
+		NextPageToken: page.NextPageToken,
+	}
+
+	if !page.ComputedAt.IsZero() {
+		msg.ComputedAt = &page.ComputedAt
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
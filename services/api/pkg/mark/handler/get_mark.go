@@ -2,8 +2,13 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) GetMark(c echo.Context) error {
@@ -18,3 +23,69 @@ func (h *Handler) GetMark(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, mark)
 }
+
+func (h *Handler) GetReleaseGroupMark(c echo.Context) error {
+	mark, err := h.cc.GetReleaseGroupMark(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed get release group mark: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, mark)
+}
+
+func (h *Handler) GetArtistMark(c echo.Context) error {
+	mark, err := h.cc.GetArtistMark(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed get artist mark: "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, mark)
+}
+
+// ListMarks pages through the marks of reviewed releases, by default the
+// highest weighted first.
+func (h *Handler) ListMarks(c echo.Context) error {
+	var pageSize, minReviews int
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		pageSize = size
+	}
+
+	if raw := c.QueryParam("min_reviews"); raw != "" {
+		reviews, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert min reviews")
+		}
+
+		minReviews = reviews
+	}
+
+	marks, next, err := h.cc.ListMarks(c.Request().Context(), &pb.ListMarksRequest{
+		OrderBy:    c.QueryParam("order_by"),
+		MinReviews: int32(minReviews),
+		PageSize:   int32(pageSize),
+		PageToken:  c.QueryParam("page_token"),
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
+		return c.String(http.StatusInternalServerError, "failed get marks "+err.Error())
+	}
+
+	msg := struct {
+		Marks         []entity.Mark `json:"marks"`
+		NextPageToken string        `json:"next_page_token,omitempty"`
+	}{
+		Marks:         marks,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
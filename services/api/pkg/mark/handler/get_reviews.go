@@ -2,19 +2,76 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// optionalInt32 parses an optional query parameter.
+func optionalInt32(c echo.Context, name string) (*int32, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	v := int32(value)
+
+	return &v, nil
+}
+
 func (h *Handler) GetReviews(c echo.Context) error {
-	releaseid := c.Param("releaseid")
+	req := &pb.GetReviewsRequest{
+		ReleaseId: c.Param("releaseid"),
+		Kind:      c.QueryParam("kind"),
+		OrderBy:   c.QueryParam("order_by"),
+		Language:  c.QueryParam("language"),
+		PageToken: c.QueryParam("page_token"),
+	}
 
-	ctx := c.Request().Context()
+	var err error
+
+	if req.MinScore, err = optionalInt32(c, "min_score"); err != nil {
+		return c.String(http.StatusBadRequest, "failed convert min score")
+	}
 
-	reviews, err := h.cc.GetReviews(ctx, releaseid)
+	if req.MaxScore, err = optionalInt32(c, "max_score"); err != nil {
+		return c.String(http.StatusBadRequest, "failed convert max score")
+	}
+
+	pageSize, err := optionalInt32(c, "page_size")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert page size")
+	}
+
+	if pageSize != nil {
+		req.PageSize = *pageSize
+	}
+
+	reviews, next, err := h.cc.GetReviews(c.Request().Context(), req)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
 		return c.String(http.StatusInternalServerError, "failed get reviews "+err.Error())
 	}
 
-	return c.JSON(http.StatusOK, reviews)
+	msg := struct {
+		Reviews       []entity.Review `json:"reviews"`
+		NextPageToken string          `json:"next_page_token,omitempty"`
+	}{
+		Reviews:       reviews,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
 }
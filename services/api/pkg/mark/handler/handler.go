@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"errors"
+
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/mark/client"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
@@ -13,3 +16,14 @@ func NewHandler(cc *client.MarkClient) *Handler {
 		cc: cc,
 	}
 }
+
+// statusMessage returns the message the mark service sent, without the
+// context the client wrapped it in.
+func statusMessage(err error) string {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Message()
+	}
+
+	return err.Error()
+}
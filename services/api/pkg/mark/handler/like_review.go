@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) LikeReview(c echo.Context) error {
+	return h.changeLike(c, "like", h.cc.LikeReview)
+}
+
+func (h *Handler) UnlikeReview(c echo.Context) error {
+	return h.changeLike(c, "unlike", h.cc.UnlikeReview)
+}
+
+func (h *Handler) changeLike(c echo.Context, action string, change func(context.Context, uint) (int64, error)) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	likes, err := change(c.Request().Context(), uint(id))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return c.String(http.StatusNotFound, "review not found")
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, "authors cannot like their own reviews")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed "+action+" review "+err.Error())
+	}
+
+	msg := struct {
+		Likes int64 `json:"likes"`
+	}{
+		Likes: likes,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) ListReviewLikers(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	pageSize := 0
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		pageSize = size
+	}
+
+	likes, next, err := h.cc.ListReviewLikers(c.Request().Context(), &pb.ListReviewLikersRequest{
+		ReviewId:  uint64(id),
+		PageSize:  int32(pageSize),
+		PageToken: c.QueryParam("page_token"),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return c.String(http.StatusNotFound, "review not found")
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, "invalid page token")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed get review likers "+err.Error())
+	}
+
+	msg := struct {
+		Likers        []entity.ReviewLike `json:"likers"`
+		NextPageToken string              `json:"next_page_token,omitempty"`
+	}{
+		Likers:        likes,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func listError(c echo.Context, err error, action string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return c.String(http.StatusBadRequest, statusMessage(err))
+	case codes.AlreadyExists:
+		return c.String(http.StatusConflict, statusMessage(err))
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, "list or item not found")
+	case codes.PermissionDenied:
+		return c.String(http.StatusForbidden, "only the author can "+action+" this list")
+	}
+
+	return c.String(http.StatusInternalServerError, "failed "+action+" list "+err.Error())
+}
+
+// listBody is the JSON body of a new or updated list.
+type listBody struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Public      bool   `json:"public"`
+}
+
+func (h *Handler) CreateList(c echo.Context) error {
+	var body listBody
+
+	if err := c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind list")
+	}
+
+	list, err := h.cc.CreateList(c.Request().Context(), &pb.CreateListRequest{
+		Name:        body.Name,
+		Description: body.Description,
+		Public:      body.Public,
+	})
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return c.String(http.StatusForbidden, "verify your email before creating lists")
+		}
+
+		return listError(c, err, "create")
+	}
+
+	return c.JSON(http.StatusCreated, list)
+}
+
+// UpdateList replaces the name, description and visibility of a list.
+func (h *Handler) UpdateList(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var body listBody
+
+	if err = c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind list")
+	}
+
+	list, err := h.cc.UpdateList(c.Request().Context(), &pb.UpdateListRequest{
+		Id:          uint64(id),
+		Name:        body.Name,
+		Description: body.Description,
+		Public:      body.Public,
+	})
+	if err != nil {
+		return listError(c, err, "update")
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
+
+func (h *Handler) DeleteList(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	if err = h.cc.DeleteList(c.Request().Context(), uint(id)); err != nil {
+		return listError(c, err, "delete")
+	}
+
+	return c.String(http.StatusOK, "deleted successfully")
+}
+
+func (h *Handler) GetList(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	list, err := h.cc.GetList(c.Request().Context(), uint(id))
+	if err != nil {
+		return listError(c, err, "get")
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
+
+// BrowseLists pages through public lists, for example
+// /v1/lists?order_by=likes or /v1/lists?user_id=...&release_id=....
+func (h *Handler) BrowseLists(c echo.Context) error {
+	size, err := queryInt(c, "page_size")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert page size")
+	}
+
+	lists, next, err := h.cc.BrowseLists(c.Request().Context(), &pb.BrowseListsRequest{
+		UserId:    c.QueryParam("user_id"),
+		ReleaseId: c.QueryParam("release_id"),
+		OrderBy:   c.QueryParam("order_by"),
+		PageSize:  int32(size),
+		PageToken: c.QueryParam("page_token"),
+	})
+	if err != nil {
+		return listError(c, err, "browse")
+	}
+
+	msg := struct {
+		Lists         []entity.List `json:"lists"`
+		NextPageToken string        `json:"next_page_token,omitempty"`
+	}{
+		Lists:         lists,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) AddListItem(c echo.Context) error {
+	listID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var body struct {
+		ReleaseID string `json:"release_id"`
+		Note      string `json:"note"`
+		Position  int32  `json:"position"`
+	}
+
+	if err = c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind list item")
+	}
+
+	item, err := h.cc.AddListItem(c.Request().Context(), &pb.AddListItemRequest{
+		ListId:    uint64(listID),
+		ReleaseId: body.ReleaseID,
+		Note:      body.Note,
+		Position:  body.Position,
+	})
+	if err != nil {
+		return listError(c, err, "add to")
+	}
+
+	return c.JSON(http.StatusCreated, item)
+}
+
+func (h *Handler) EditListItem(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("item"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var body struct {
+		Note string `json:"note"`
+	}
+
+	if err = c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind list item")
+	}
+
+	item, err := h.cc.EditListItem(c.Request().Context(), uint(id), body.Note)
+	if err != nil {
+		return listError(c, err, "edit")
+	}
+
+	return c.JSON(http.StatusOK, item)
+}
+
+// MoveListItem puts an item at the position of the body; positions past
+// the end of the list move it to the end.
+func (h *Handler) MoveListItem(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("item"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var body struct {
+		Position int `json:"position"`
+	}
+
+	if err = c.Bind(&body); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind position")
+	}
+
+	item, err := h.cc.MoveListItem(c.Request().Context(), uint(id), body.Position)
+	if err != nil {
+		return listError(c, err, "reorder")
+	}
+
+	return c.JSON(http.StatusOK, item)
+}
+
+func (h *Handler) RemoveListItem(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("item"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	if err = h.cc.RemoveListItem(c.Request().Context(), uint(id)); err != nil {
+		return listError(c, err, "remove from")
+	}
+
+	return c.String(http.StatusOK, "removed successfully")
+}
+
+func (h *Handler) GetListItems(c echo.Context) error {
+	listID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	size, err := queryInt(c, "page_size")
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed convert page size")
+	}
+
+	items, next, err := h.cc.GetListItems(c.Request().Context(), &pb.GetListItemsRequest{
+		ListId:    uint64(listID),
+		PageSize:  int32(size),
+		PageToken: c.QueryParam("page_token"),
+	})
+	if err != nil {
+		return listError(c, err, "get")
+	}
+
+	msg := struct {
+		Items         []entity.ListItem `json:"items"`
+		NextPageToken string            `json:"next_page_token,omitempty"`
+	}{
+		Items:         items,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) LikeList(c echo.Context) error {
+	return h.changeListLike(c, "like", h.cc.LikeList)
+}
+
+func (h *Handler) UnlikeList(c echo.Context) error {
+	return h.changeListLike(c, "unlike", h.cc.UnlikeList)
+}
+
+func (h *Handler) changeListLike(c echo.Context, action string, change func(context.Context, uint) (int64, error)) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	likes, err := change(c.Request().Context(), uint(id))
+	if err != nil {
+		return listError(c, err, action)
+	}
+
+	msg := struct {
+		Likes int64 `json:"likes"`
+	}{
+		Likes: likes,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func moderationError(c echo.Context, err error, action string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return c.String(http.StatusBadRequest, statusMessage(err))
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, "review not found")
+	case codes.AlreadyExists:
+		return c.String(http.StatusConflict, statusMessage(err))
+	case codes.PermissionDenied:
+		return c.String(http.StatusForbidden, statusMessage(err))
+	}
+
+	return c.String(http.StatusInternalServerError, "failed "+action+" "+err.Error())
+}
+
+func (h *Handler) ReportReview(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var req struct {
+		Reason  string `json:"reason"`
+		Details string `json:"details"`
+	}
+
+	if err = c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind report")
+	}
+
+	if err = h.cc.ReportReview(c.Request().Context(), uint(id), req.Reason, req.Details); err != nil {
+		return moderationError(c, err, "report review")
+	}
+
+	return c.String(http.StatusCreated, "reported successfully")
+}
+
+func (h *Handler) ListModerationQueue(c echo.Context) error {
+	pageSize := 0
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		pageSize = size
+	}
+
+	items, next, err := h.cc.ListModerationQueue(c.Request().Context(), pageSize, c.QueryParam("page_token"))
+	if err != nil {
+		return moderationError(c, err, "list moderation queue")
+	}
+
+	msg := struct {
+		Items         []entity.ModerationItem `json:"items"`
+		NextPageToken string                  `json:"next_page_token,omitempty"`
+	}{
+		Items:         items,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) HideReview(c echo.Context) error {
+	return h.moderateReview(c, "hide review", h.cc.HideReview)
+}
+
+func (h *Handler) RestoreReview(c echo.Context) error {
+	return h.moderateReview(c, "restore review", h.cc.RestoreReview)
+}
+
+func (h *Handler) moderateReview(c echo.Context, action string, moderate func(context.Context, uint, string) (*entity.Review, error)) error {
+	id, note, err := bindModeration(c)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	review, err := moderate(c.Request().Context(), id, note)
+	if err != nil {
+		return moderationError(c, err, action)
+	}
+
+	return c.JSON(http.StatusOK, review)
+}
+
+func (h *Handler) ResolveReports(c echo.Context) error {
+	id, note, err := bindModeration(c)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	resolved, err := h.cc.ResolveReports(c.Request().Context(), id, note)
+	if err != nil {
+		return moderationError(c, err, "resolve reports")
+	}
+
+	msg := struct {
+		Resolved int64 `json:"resolved"`
+	}{
+		Resolved: resolved,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+// bindModeration reads the review id from the path and the optional note
+// for the moderation log from the body.
+func bindModeration(c echo.Context) (uint, string, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return 0, "", errors.New("faield convert id to int")
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+
+	if err = c.Bind(&req); err != nil {
+		return 0, "", errors.New("failed bind moderation note")
+	}
+
+	return uint(id), req.Note, nil
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rate sets the caller's score for a release without writing a review.
+func (h *Handler) Rate(c echo.Context) error {
+	var req struct {
+		Score int `json:"score"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "faield bind rating")
+	}
+
+	review, err := h.cc.Rate(c.Request().Context(), c.Param("releaseid"), req.Score)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			return c.String(http.StatusForbidden, "verify your email before rating releases")
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
+		return c.String(http.StatusInternalServerError, "failed rate release "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, review)
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) UpdateReview(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	var update entity.Review
+
+	if err = c.Bind(&update); err != nil {
+		return c.String(http.StatusBadRequest, "faield bind review")
+	}
+
+	review, err := h.cc.UpdateReview(c.Request().Context(), uint(id), update.Text, update.Language, update.Count)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return c.String(http.StatusNotFound, "review not found")
+		case codes.PermissionDenied:
+			return c.String(http.StatusForbidden, "only the author can edit this review")
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		}
+
+		return c.String(http.StatusInternalServerError, "failed update review "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, review)
+}
+
+func (h *Handler) ListReviewRevisions(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "faield convert id to int")
+	}
+
+	revisions, err := h.cc.ListReviewRevisions(c.Request().Context(), uint(id))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.String(http.StatusNotFound, "review not found")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed get review revisions "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, revisions)
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/api/config"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/music/client"
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/music/handler"
 	"github.com/osamikoyo/music-and-marks/services/music/api/proto/gen/pb"
@@ -15,9 +17,10 @@ import (
 
 type MusicCore struct {
 	handler *handler.Handler
+	auth    *auth.Auth
 }
 
-func SetupMusicCore(cfg *config.Config, logger *logger.Logger) (*MusicCore, error) {
+func SetupMusicCore(cfg *config.Config, auth *auth.Auth, logger *logger.Logger) (*MusicCore, error) {
 	conn, err := grpc.NewClient(cfg.MarkServiceAddr)
 	if err != nil {
 		logger.Error("failed connect to mark service",
@@ -34,14 +37,19 @@ func SetupMusicCore(cfg *config.Config, logger *logger.Logger) (*MusicCore, erro
 
 	return &MusicCore{
 		handler: handler,
+		auth:    auth,
 	}, nil
 }
 
+// RegisterHandler exposes the public catalog. Personal access tokens need
+// the catalog:read scope here.
 func (m *MusicCore) RegisterHandler(e *echo.Echo) {
-	e.GET("/search", m.handler.Search)
-	e.GET("/release/:id", m.handler.GetRelease)
-	e.GET("/artist/:id", m.handler.GetArtist)
-	e.GET("/releases", m.handler.ReadReleases)
-	e.GET("/artists", m.handler.ReadArtists)
+	read := m.auth.RequireScope(authz.ScopeCatalogRead)
+
+	e.GET("/search", m.handler.Search, m.auth.Optional, read)
+	e.GET("/release/:id", m.handler.GetRelease, m.auth.Optional, read)
+	e.GET("/artist/:id", m.handler.GetArtist, m.auth.Optional, read)
+	e.GET("/releases", m.handler.ReadReleases, m.auth.Optional, read)
+	e.GET("/artists", m.handler.ReadArtists, m.auth.Optional, read)
 }
 
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/user/entity"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var ErrNilInput = errors.New("input is nil")
@@ -26,15 +29,17 @@ func NewUserClient(cc pb.UserServiceClient, logger *logger.Logger) *UserClient {
 	}
 }
 
-func (u *UserClient) Register(ctx context.Context, user *entity.User) (*entity.TokenPair, error) {
+func (u *UserClient) Register(ctx context.Context, user *entity.User, client entity.ClientInfo) (*entity.TokenPair, error) {
 	if user == nil {
 		return nil, ErrNilInput
 	}
 
 	tokens, err := u.cc.Register(ctx, &pb.RegisterRequest{
-		Username: user.Username,
-		Password: user.Password,
-		Email:    user.Email,
+		Username:  user.Username,
+		Password:  user.Password,
+		Email:     user.Email,
+		UserAgent: client.UserAgent,
+		IpAddress: client.IPAddress,
 	})
 	if err != nil {
 		u.logger.Error("failed register",
@@ -50,19 +55,54 @@ func (u *UserClient) Register(ctx context.Context, user *entity.User) (*entity.T
 	}, nil
 }
 
-func (u *UserClient) Login(ctx context.Context, email, password string) (*entity.TokenPair, error) {
+// Login returns tokens, or an mfa ticket for VerifyMFA when the account
+// has two-factor authentication enabled.
+func (u *UserClient) Login(ctx context.Context, email, password string, client entity.ClientInfo) (*entity.TokenPair, string, error) {
 	if email == "" || password == "" {
-		return nil, ErrNilInput
+		return nil, "", ErrNilInput
 	}
 
-	tokens, err := u.cc.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	resp, err := u.cc.Login(ctx, &pb.LoginRequest{
+		Email:     email,
+		Password:  password,
+		UserAgent: client.UserAgent,
+		IpAddress: client.IPAddress,
+	})
 	if err != nil {
 		u.logger.Error("failed login",
 			zap.String("email", email),
 			zap.String("password", password),
 			zap.Error(err))
 
-		return nil, fmt.Errorf("failed login: %w", err)
+		return nil, "", fmt.Errorf("failed login: %w", err)
+	}
+
+	if resp.MfaRequired {
+		return nil, resp.MfaTicket, nil
+	}
+
+	return &entity.TokenPair{
+		AccessToken:  resp.Tokens.GetAccess(),
+		RefreshToken: resp.Tokens.GetRefresh(),
+	}, "", nil
+}
+
+func (u *UserClient) VerifyMFA(ctx context.Context, ticket, code string, client entity.ClientInfo) (*entity.TokenPair, error) {
+	if ticket == "" || code == "" {
+		return nil, ErrNilInput
+	}
+
+	tokens, err := u.cc.VerifyMFA(ctx, &pb.VerifyMFARequest{
+		MfaTicket: ticket,
+		Code:      code,
+		UserAgent: client.UserAgent,
+		IpAddress: client.IPAddress,
+	})
+	if err != nil {
+		u.logger.Error("failed verify mfa",
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed verify mfa: %w", err)
 	}
 
 	return &entity.TokenPair{
@@ -71,6 +111,63 @@ func (u *UserClient) Login(ctx context.Context, email, password string) (*entity
 	}, nil
 }
 
+func (u *UserClient) EnrollTOTP(ctx context.Context, userID string) (*pb.EnrollTOTPResponse, error) {
+	if userID == "" {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.EnrollTOTP(ctx, &pb.EnrollTOTPRequest{UserId: userID})
+	if err != nil {
+		u.logger.Error("failed enroll totp",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed enroll totp: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (u *UserClient) ConfirmTOTP(ctx context.Context, userID, code string) error {
+	if userID == "" || code == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.ConfirmTOTP(ctx, &pb.ConfirmTOTPRequest{
+		UserId: userID,
+		Code:   code,
+	})
+	if err != nil {
+		u.logger.Error("failed confirm totp",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed confirm totp: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) DisableTOTP(ctx context.Context, userID, code string) error {
+	if userID == "" || code == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.DisableTOTP(ctx, &pb.DisableTOTPRequest{
+		UserId: userID,
+		Code:   code,
+	})
+	if err != nil {
+		u.logger.Error("failed disable totp",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed disable totp: %w", err)
+	}
+
+	return nil
+}
+
 func (u *UserClient) GetUser(ctx context.Context, id string) (*entity.User, error) {
 	if id == "" {
 		return nil, ErrNilInput
@@ -85,24 +182,7 @@ func (u *UserClient) GetUser(ctx context.Context, id string) (*entity.User, erro
 		return nil, fmt.Errorf("failed get user: %w", err)
 	}
 
-	uid, err := uuid.Parse(resp.Id)
-	if err != nil {
-		u.logger.Error("failed parse id from resp",
-			zap.String("id", resp.Id),
-			zap.Error(err))
-
-		return nil, fmt.Errorf("failed parse id: %w", err)
-	}
-
-	return &entity.User{
-		ID:        uid,
-		Username:  resp.Username,
-		Email:     resp.Email,
-		Reviews:   int(resp.Reviews),
-		Likes:     int(resp.Likes),
-		CreatedAt: resp.CreatedAt.AsTime(),
-		UpdatedAt: resp.UpdatedAt.AsTime(),
-	}, nil
+	return u.userFromProto(resp)
 }
 
 func (u *UserClient) ChangePassword(ctx context.Context, id, currentPassword, newPassword string) error {
@@ -126,6 +206,27 @@ func (u *UserClient) ChangePassword(ctx context.Context, id, currentPassword, ne
 	return nil
 }
 
+func (u *UserClient) ChangeEmail(ctx context.Context, id, currentPassword, email string) (*entity.User, error) {
+	if id == "" || currentPassword == "" || email == "" {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.ChangeEmail(ctx, &pb.ChangeEmailRequest{
+		Id:              id,
+		CurrentPassword: currentPassword,
+		Email:           email,
+	})
+	if err != nil {
+		u.logger.Error("failed change email",
+			zap.String("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed change email: %w", err)
+	}
+
+	return u.userFromProto(resp)
+}
+
 func (u *UserClient) DeleteUser(ctx context.Context, id string) error {
 	if id == "" {
 		return ErrNilInput
@@ -143,9 +244,9 @@ func (u *UserClient) DeleteUser(ctx context.Context, id string) error {
 	return nil
 }
 
-func (u *UserClient) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+func (u *UserClient) RefreshToken(ctx context.Context, refreshToken string) (*entity.TokenPair, error) {
 	if refreshToken == "" {
-		return "", ErrNilInput
+		return nil, ErrNilInput
 	}
 
 	resp, err := u.cc.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: refreshToken})
@@ -153,10 +254,404 @@ func (u *UserClient) RefreshToken(ctx context.Context, refreshToken string) (str
 		u.logger.Error("failed refresh token",
 			zap.Error(err))
 
-		return "", fmt.Errorf("failed refresh token: %w", err)
+		return nil, fmt.Errorf("failed refresh token: %w", err)
+	}
+
+	return &entity.TokenPair{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (u *UserClient) Logout(ctx context.Context, refreshToken string) error {
+	if refreshToken == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.Logout(ctx, &pb.LogoutRequest{RefreshToken: refreshToken})
+	if err != nil {
+		u.logger.Error("failed logout",
+			zap.Error(err))
+
+		return fmt.Errorf("failed logout: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) SendVerificationEmail(ctx context.Context, userID string) error {
+	if userID == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.SendVerificationEmail(ctx, &pb.SendVerificationEmailRequest{UserId: userID})
+	if err != nil {
+		u.logger.Error("failed send verification email",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed send verification email: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) VerifyEmail(ctx context.Context, token string) (*entity.User, error) {
+	if token == "" {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: token})
+	if err != nil {
+		u.logger.Error("failed verify email",
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed verify email: %w", err)
+	}
+
+	return u.userFromProto(resp)
+}
+
+func (u *UserClient) RequestPasswordReset(ctx context.Context, email string) error {
+	if email == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: email})
+	if err != nil {
+		u.logger.Error("failed request password reset",
+			zap.Error(err))
+
+		return fmt.Errorf("failed request password reset: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if token == "" || newPassword == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.ResetPassword(ctx, &pb.ResetPasswordRequest{
+		Token:       token,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		u.logger.Error("failed reset password",
+			zap.Error(err))
+
+		return fmt.Errorf("failed reset password: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) ListSessions(ctx context.Context, userID string) ([]entity.Session, error) {
+	if userID == "" {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.ListSessions(ctx, &pb.ListSessionsRequest{UserId: userID})
+	if err != nil {
+		u.logger.Error("failed list sessions",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list sessions: %w", err)
+	}
+
+	sessions := make([]entity.Session, len(resp.Sessions))
+
+	for i, session := range resp.Sessions {
+		sid, err := uuid.Parse(session.Id)
+		if err != nil {
+			u.logger.Error("failed parse id from resp",
+				zap.String("id", session.Id),
+				zap.Error(err))
+
+			return nil, fmt.Errorf("failed parse id: %w", err)
+		}
+
+		sessions[i] = entity.Session{
+			ID:         sid,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IpAddress,
+			CreatedAt:  session.CreatedAt.AsTime(),
+			LastUsedAt: session.LastUsedAt.AsTime(),
+			ExpiresAt:  session.ExpiresAt.AsTime(),
+		}
+	}
+
+	return sessions, nil
+}
+
+func (u *UserClient) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if userID == "" || sessionID == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.RevokeSession(ctx, &pb.RevokeSessionRequest{
+		UserId:    userID,
+		SessionId: sessionID,
+	})
+	if err != nil {
+		u.logger.Error("failed revoke session",
+			zap.String("user_id", userID),
+			zap.String("session_id", sessionID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed revoke session: %w", err)
+	}
+
+	return nil
+}
+
+// CreatePersonalToken returns the stored token and the secret, which the
+// user service does not return again.
+func (u *UserClient) CreatePersonalToken(ctx context.Context, userID, name string, scopes []string, expiresAt time.Time) (*entity.PersonalToken, string, error) {
+	if userID == "" {
+		return nil, "", ErrNilInput
+	}
+
+	req := &pb.CreatePersonalTokenRequest{
+		UserId: userID,
+		Name:   name,
+		Scopes: scopes,
+	}
+
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	resp, err := u.cc.CreatePersonalToken(ctx, req)
+	if err != nil {
+		u.logger.Error("failed create personal token",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed create personal token: %w", err)
+	}
+
+	token, err := u.personalTokenFromProto(resp.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, resp.Secret, nil
+}
+
+func (u *UserClient) ListPersonalTokens(ctx context.Context, userID string) ([]entity.PersonalToken, error) {
+	if userID == "" {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.ListPersonalTokens(ctx, &pb.ListPersonalTokensRequest{UserId: userID})
+	if err != nil {
+		u.logger.Error("failed list personal tokens",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list personal tokens: %w", err)
+	}
+
+	tokens := make([]entity.PersonalToken, len(resp.Tokens))
+
+	for i, pbtoken := range resp.Tokens {
+		token, err := u.personalTokenFromProto(pbtoken)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens[i] = *token
+	}
+
+	return tokens, nil
+}
+
+func (u *UserClient) RevokePersonalToken(ctx context.Context, userID, tokenID string) error {
+	if userID == "" || tokenID == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.RevokePersonalToken(ctx, &pb.RevokePersonalTokenRequest{
+		UserId:  userID,
+		TokenId: tokenID,
+	})
+	if err != nil {
+		u.logger.Error("failed revoke personal token",
+			zap.String("user_id", userID),
+			zap.String("token_id", tokenID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed revoke personal token: %w", err)
+	}
+
+	return nil
+}
+
+// ExchangePersonalToken returns a scoped access token for the personal
+// token and its expiry.
+func (u *UserClient) ExchangePersonalToken(ctx context.Context, token string) (string, time.Time, error) {
+	if token == "" {
+		return "", time.Time{}, ErrNilInput
+	}
+
+	resp, err := u.cc.ExchangePersonalToken(ctx, &pb.ExchangePersonalTokenRequest{Token: token})
+	if err != nil {
+		u.logger.Warn("failed exchange personal token",
+			zap.Error(err))
+
+		return "", time.Time{}, fmt.Errorf("failed exchange personal token: %w", err)
+	}
+
+	return resp.AccessToken, resp.ExpiresAt.AsTime(), nil
+}
+
+func (u *UserClient) personalTokenFromProto(resp *pb.PersonalToken) (*entity.PersonalToken, error) {
+	id, err := uuid.Parse(resp.Id)
+	if err != nil {
+		u.logger.Error("failed parse id from resp",
+			zap.String("id", resp.Id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed parse id: %w", err)
+	}
+
+	token := &entity.PersonalToken{
+		ID:        id,
+		Name:      resp.Name,
+		Scopes:    resp.Scopes,
+		Hint:      resp.Hint,
+		CreatedAt: resp.CreatedAt.AsTime(),
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+	}
+
+	if resp.LastUsedAt != nil {
+		used := resp.LastUsedAt.AsTime()
+		token.LastUsedAt = &used
+	}
+
+	return token, nil
+}
+
+// BeginOIDC returns the authorization URL of the provider and the state
+// to bind to the browser. A user id links the provider instead of signing
+// in.
+func (u *UserClient) BeginOIDC(ctx context.Context, provider, userID string) (string, string, error) {
+	if provider == "" {
+		return "", "", ErrNilInput
+	}
+
+	resp, err := u.cc.BeginOIDC(ctx, &pb.BeginOIDCRequest{
+		Provider: provider,
+		UserId:   userID,
+	})
+	if err != nil {
+		u.logger.Error("failed begin oidc",
+			zap.String("provider", provider),
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return "", "", fmt.Errorf("failed begin oidc: %w", err)
+	}
+
+	return resp.AuthorizationUrl, resp.State, nil
+}
+
+// CompleteOIDC returns tokens or an mfa ticket for a sign-in, and the
+// linked identity when the flow linked a provider.
+func (u *UserClient) CompleteOIDC(ctx context.Context, provider, state, code string, client entity.ClientInfo) (*entity.TokenPair, string, *entity.Identity, error) {
+	if provider == "" || state == "" || code == "" {
+		return nil, "", nil, ErrNilInput
+	}
+
+	resp, err := u.cc.CompleteOIDC(ctx, &pb.CompleteOIDCRequest{
+		Provider:  provider,
+		State:     state,
+		Code:      code,
+		UserAgent: client.UserAgent,
+		IpAddress: client.IPAddress,
+	})
+	if err != nil {
+		u.logger.Error("failed complete oidc",
+			zap.String("provider", provider),
+			zap.Error(err))
+
+		return nil, "", nil, fmt.Errorf("failed complete oidc: %w", err)
+	}
+
+	if resp.Linked != nil {
+		return nil, "", identityFromProto(resp.Linked), nil
+	}
+
+	if resp.MfaRequired {
+		return nil, resp.MfaTicket, nil, nil
+	}
+
+	return &entity.TokenPair{
+		AccessToken:  resp.Tokens.GetAccess(),
+		RefreshToken: resp.Tokens.GetRefresh(),
+	}, "", nil, nil
+}
+
+func (u *UserClient) ListIdentities(ctx context.Context, userID string) ([]entity.Identity, error) {
+	if userID == "" {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.ListIdentities(ctx, &pb.ListIdentitiesRequest{UserId: userID})
+	if err != nil {
+		u.logger.Error("failed list identities",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list identities: %w", err)
+	}
+
+	identities := make([]entity.Identity, len(resp.Identities))
+	for i, identity := range resp.Identities {
+		identities[i] = *identityFromProto(identity)
+	}
+
+	return identities, nil
+}
+
+func (u *UserClient) UnlinkIdentity(ctx context.Context, userID, provider string) error {
+	if userID == "" || provider == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.UnlinkIdentity(ctx, &pb.UnlinkIdentityRequest{
+		UserId:   userID,
+		Provider: provider,
+	})
+	if err != nil {
+		u.logger.Error("failed unlink identity",
+			zap.String("user_id", userID),
+			zap.String("provider", provider),
+			zap.Error(err))
+
+		return fmt.Errorf("failed unlink identity: %w", err)
+	}
+
+	return nil
+}
+
+func identityFromProto(resp *pb.Identity) *entity.Identity {
+	identity := &entity.Identity{
+		Provider:  resp.Provider,
+		Subject:   resp.Subject,
+		Email:     resp.Email,
+		CreatedAt: resp.CreatedAt.AsTime(),
+	}
+
+	if resp.LastLoginAt != nil {
+		at := resp.LastLoginAt.AsTime()
+		identity.LastLoginAt = &at
 	}
 
-	return resp.AccessToken, nil
+	return identity
 }
 
 func (u *UserClient) IncLike(ctx context.Context, userID string) error {
@@ -226,3 +721,201 @@ func (u *UserClient) DecReview(ctx context.Context, userID string) error {
 
 	return nil
 }
+
+func (u *UserClient) SetUserRole(ctx context.Context, id, role string) error {
+	if id == "" || role == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.SetUserRole(ctx, &pb.SetUserRoleRequest{
+		Id:   id,
+		Role: role,
+	})
+	if err != nil {
+		u.logger.Error("failed set user role",
+			zap.String("id", id),
+			zap.String("role", role),
+			zap.Error(err))
+
+		return fmt.Errorf("failed set user role: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) userFromProto(resp *pb.User) (*entity.User, error) {
+	uid, err := uuid.Parse(resp.Id)
+	if err != nil {
+		u.logger.Error("failed parse id from resp",
+			zap.String("id", resp.Id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed parse id: %w", err)
+	}
+
+	return &entity.User{
+		ID:          uid,
+		Username:    resp.Username,
+		Email:       resp.Email,
+		Reviews:     int(resp.Reviews),
+		Likes:       int(resp.Likes),
+		Role:        resp.Role,
+		Followers:   int(resp.Followers),
+		Following:   int(resp.Following),
+		DisplayName: resp.DisplayName,
+
+		EmailVerified: resp.EmailVerified,
+		TOTPEnabled:   resp.TotpEnabled,
+		Bio:           resp.Bio,
+		AvatarURL:     resp.AvatarUrl,
+		CreatedAt:     resp.CreatedAt.AsTime(),
+		UpdatedAt:     resp.UpdatedAt.AsTime(),
+	}, nil
+}
+
+func (u *UserClient) UpdateUser(ctx context.Context, id string, user *entity.User, paths []string) (*entity.User, error) {
+	if id == "" || user == nil {
+		return nil, ErrNilInput
+	}
+
+	resp, err := u.cc.UpdateUser(ctx, &pb.UpdateUserRequest{
+		Id: id,
+		User: &pb.User{
+			Username:    user.Username,
+			Email:       user.Email,
+			DisplayName: user.DisplayName,
+			Bio:         user.Bio,
+			AvatarUrl:   user.AvatarURL,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	})
+	if err != nil {
+		u.logger.Error("failed update user",
+			zap.String("id", id),
+			zap.Strings("paths", paths),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed update user: %w", err)
+	}
+
+	return u.userFromProto(resp)
+}
+
+func (u *UserClient) ListUsers(ctx context.Context, req *pb.ListUsersRequest) ([]entity.User, string, int, error) {
+	if req == nil {
+		return nil, "", 0, ErrNilInput
+	}
+
+	resp, err := u.cc.ListUsers(ctx, req)
+	if err != nil {
+		u.logger.Error("failed list users",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", 0, fmt.Errorf("failed list users: %w", err)
+	}
+
+	users := make([]entity.User, len(resp.Users))
+
+	for i, pbuser := range resp.Users {
+		user, err := u.userFromProto(pbuser)
+		if err != nil {
+			return nil, "", 0, err
+		}
+
+		users[i] = *user
+	}
+
+	return users, resp.NextPageToken, int(resp.TotalSize), nil
+}
+
+func (u *UserClient) Follow(ctx context.Context, followerID, followeeID string) error {
+	if followerID == "" || followeeID == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.Follow(ctx, &pb.FollowRequest{
+		FollowerId: followerID,
+		FolloweeId: followeeID,
+	})
+	if err != nil {
+		u.logger.Error("failed follow",
+			zap.String("follower_id", followerID),
+			zap.String("followee_id", followeeID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed follow: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	if followerID == "" || followeeID == "" {
+		return ErrNilInput
+	}
+
+	_, err := u.cc.Unfollow(ctx, &pb.UnfollowRequest{
+		FollowerId: followerID,
+		FolloweeId: followeeID,
+	})
+	if err != nil {
+		u.logger.Error("failed unfollow",
+			zap.String("follower_id", followerID),
+			zap.String("followee_id", followeeID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed unfollow: %w", err)
+	}
+
+	return nil
+}
+
+func (u *UserClient) ListFollowers(ctx context.Context, req *pb.ListFollowsRequest) ([]entity.User, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.ListFollowers(ctx, req)
+	if err != nil {
+		u.logger.Error("failed list followers",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed list followers: %w", err)
+	}
+
+	return u.followsFromProto(resp)
+}
+
+func (u *UserClient) ListFollowing(ctx context.Context, req *pb.ListFollowsRequest) ([]entity.User, string, error) {
+	if req == nil {
+		return nil, "", ErrNilInput
+	}
+
+	resp, err := u.cc.ListFollowing(ctx, req)
+	if err != nil {
+		u.logger.Error("failed list following",
+			zap.Any("req", req),
+			zap.Error(err))
+
+		return nil, "", fmt.Errorf("failed list following: %w", err)
+	}
+
+	return u.followsFromProto(resp)
+}
+
+func (u *UserClient) followsFromProto(resp *pb.ListFollowsResponse) ([]entity.User, string, error) {
+	users := make([]entity.User, len(resp.Users))
+
+	for i, pbuser := range resp.Users {
+		user, err := u.userFromProto(pbuser)
+		if err != nil {
+			return nil, "", err
+		}
+
+		users[i] = *user
+	}
+
+	return users, resp.NextPageToken, nil
+}
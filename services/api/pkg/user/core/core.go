@@ -4,21 +4,25 @@ import (
 	"fmt"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/api/config"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/user/client"
 	"github.com/osamikoyo/music-and-marks/services/api/pkg/user/handler"
 	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type UserCore struct {
 	handler *handler.Handler
+	auth    *auth.Auth
 }
 
-func SetupUserCore(cfg *config.Config, logger *logger.Logger) (*UserCore, error) {
-	conn, err := grpc.NewClient(cfg.UserServiceAddr)
+func SetupUserCore(cfg *config.Config, auth *auth.Auth, logger *logger.Logger) (*UserCore, error) {
+	conn, err := grpc.NewClient(cfg.UserServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		logger.Error("failed connect to user service",
 			zap.String("addr", cfg.UserServiceAddr),
@@ -29,12 +33,68 @@ func SetupUserCore(cfg *config.Config, logger *logger.Logger) (*UserCore, error)
 
 	c := pb.NewUserServiceClient(conn)
 	client := client.NewUserClient(c, logger)
-	handler := handler.NewHandler(client)
+	handler := handler.NewHandler(client, cfg.Cookie)
+
+	auth.UsePersonalTokens(client)
 
 	return &UserCore{
 		handler: handler,
+		auth:    auth,
 	}, nil
 }
 
+// RegisterHandler exposes the account API. Review and like counters are
+// maintained service-to-service and intentionally have no public routes.
 func (u *UserCore) RegisterHandler(e *echo.Echo) {
+	e.GET(authz.JWKSPath, u.auth.JWKS)
+
+	v1 := e.Group("/v1")
+
+	authg := v1.Group("/auth")
+	authg.POST("/register", u.handler.Register)
+	authg.POST("/login", u.handler.Login)
+	authg.POST("/refresh", u.handler.RefreshToken)
+	authg.POST("/logout", u.handler.Logout)
+	authg.POST("/mfa", u.handler.VerifyMFA)
+	authg.POST("/verify-email", u.handler.VerifyEmail)
+	authg.POST("/password/forgot", u.handler.RequestPasswordReset)
+	authg.POST("/password/reset", u.handler.ResetPassword)
+	authg.GET("/oidc/:provider", u.handler.BeginOIDCLogin)
+	authg.GET("/oidc/:provider/callback", u.handler.OIDCCallback)
+
+	v1.GET("/users", u.handler.ListUsers)
+	v1.GET("/users/:id", u.handler.GetUser)
+	v1.GET("/users/:id/followers", u.handler.ListFollowers)
+	v1.GET("/users/:id/following", u.handler.ListFollowing)
+
+	follow := v1.Group("/users/:id/follow", u.auth.Middleware, u.auth.RequireScope(authz.ScopeProfileWrite))
+	follow.POST("", u.handler.Follow)
+	follow.DELETE("", u.handler.Unfollow)
+
+	me := v1.Group("/me", u.auth.Middleware)
+	me.GET("", u.handler.Me, u.auth.RequireScope(authz.ScopeProfileRead))
+	me.PATCH("", u.handler.UpdateUser, u.auth.RequireScope(authz.ScopeProfileWrite))
+	me.PUT("/password", u.handler.ChangePassword, u.auth.RequireSession)
+	me.PUT("/email", u.handler.ChangeEmail, u.auth.RequireSession)
+	me.POST("/verification-email", u.handler.SendVerificationEmail, u.auth.RequireScope(authz.ScopeProfileWrite))
+	me.POST("/mfa/totp", u.handler.EnrollTOTP, u.auth.RequireSession)
+	me.POST("/mfa/totp/confirm", u.handler.ConfirmTOTP, u.auth.RequireSession)
+	me.POST("/mfa/totp/disable", u.handler.DisableTOTP, u.auth.RequireSession)
+	me.DELETE("", u.handler.DeleteUser, u.auth.RequireSession)
+	me.GET("/sessions", u.handler.ListSessions, u.auth.RequireScope(authz.ScopeProfileRead))
+	me.DELETE("/sessions/:id", u.handler.RevokeSession, u.auth.RequireSession)
+
+	me.GET("/identities", u.handler.ListIdentities, u.auth.RequireScope(authz.ScopeProfileRead))
+	me.POST("/identities/:provider", u.handler.LinkIdentity, u.auth.RequireSession)
+	me.DELETE("/identities/:provider", u.handler.UnlinkIdentity, u.auth.RequireSession)
+
+	tokens := me.Group("/tokens", u.auth.RequireSession)
+	tokens.GET("", u.handler.ListPersonalTokens)
+	tokens.POST("", u.handler.CreatePersonalToken)
+	tokens.DELETE("/:id", u.handler.RevokePersonalToken)
+
+	admin := v1.Group("/admin", u.auth.Middleware, u.auth.RequireScope(authz.ScopeUsersAdmin), u.auth.Require(authz.PermManageUsers))
+	admin.PUT("/users/:id/role", u.handler.SetRole)
+	admin.PATCH("/users/:id", u.handler.UpdateUser)
+	admin.DELETE("/users/:id", u.handler.DeleteUser)
 }
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ChangeEmail replaces the caller's email; the current password is asked
+// for since password resets go to the new address.
+func (h *Handler) ChangeEmail(c echo.Context) error {
+	var req struct {
+		Password string `json:"password"`
+		Email    string `json:"email"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind email")
+	}
+
+	if req.Password == "" || req.Email == "" {
+		return c.String(http.StatusBadRequest, "password and email are required")
+	}
+
+	user, err := h.cc.ChangeEmail(c.Request().Context(), auth.UserID(c), req.Password, req.Email)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.AlreadyExists:
+			return c.String(http.StatusConflict, status.Convert(err).Message())
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, status.Convert(err).Message())
+		case codes.PermissionDenied:
+			return c.String(http.StatusForbidden, status.Convert(err).Message())
+		}
+
+		return c.String(http.StatusInternalServerError, "failed change email "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
@@ -4,17 +4,18 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 )
 
 func (h *Handler) ChangePassword(c echo.Context) error {
-	id := c.Param("id")
+	id := auth.UserID(c)
 
 	var req struct {
 		Old string `json:"old"`
 		New string `json:"new"`
 	}
 
-	if err := c.Bind(req); err != nil {
+	if err := c.Bind(&req); err != nil {
 		return c.String(http.StatusBadRequest, "failed bind passwords")
 	}
 
@@ -4,10 +4,11 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 )
 
 func (h *Handler) DeleteUser(c echo.Context) error {
-	id := c.Param("id")
+	id := targetID(c)
 
 	ctx := c.Request().Context()
 
@@ -15,5 +16,9 @@ func (h *Handler) DeleteUser(c echo.Context) error {
 		return c.String(http.StatusInternalServerError, "failed delete user "+err.Error())
 	}
 
+	if id == auth.UserID(c) {
+		h.clearRefreshCookie(c)
+	}
+
 	return c.String(http.StatusOK, "deleted successfully")
 }
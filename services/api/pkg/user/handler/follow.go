@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) Follow(c echo.Context) error {
+	if err := h.cc.Follow(c.Request().Context(), auth.UserID(c), c.Param("id")); err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		case codes.NotFound:
+			return c.String(http.StatusNotFound, "user not found")
+		case codes.AlreadyExists:
+			return c.String(http.StatusConflict, "already following")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed follow "+err.Error())
+	}
+
+	return c.String(http.StatusOK, "followed successfully")
+}
+
+func (h *Handler) Unfollow(c echo.Context) error {
+	if err := h.cc.Unfollow(c.Request().Context(), auth.UserID(c), c.Param("id")); err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		case codes.NotFound:
+			return c.String(http.StatusNotFound, "not following")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed unfollow "+err.Error())
+	}
+
+	return c.String(http.StatusOK, "unfollowed successfully")
+}
+
+func (h *Handler) ListFollowers(c echo.Context) error {
+	return h.listFollows(c, "followers", h.cc.ListFollowers)
+}
+
+func (h *Handler) ListFollowing(c echo.Context) error {
+	return h.listFollows(c, "following", h.cc.ListFollowing)
+}
+
+func (h *Handler) listFollows(
+	c echo.Context,
+	name string,
+	list func(context.Context, *pb.ListFollowsRequest) ([]entity.User, string, error),
+) error {
+	pageSize := 0
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		pageSize = size
+	}
+
+	users, next, err := list(c.Request().Context(), &pb.ListFollowsRequest{
+		UserId:    c.Param("id"),
+		PageSize:  int32(pageSize),
+		PageToken: c.QueryParam("page_token"),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, statusMessage(err))
+		case codes.NotFound:
+			return c.String(http.StatusNotFound, "user not found")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed list "+name+" "+err.Error())
+	}
+
+	// public listings do not expose contact data
+	for i := range users {
+		users[i].Email = ""
+	}
+
+	msg := struct {
+		Users         []entity.User `json:"users"`
+		NextPageToken string        `json:"next_page_token,omitempty"`
+	}{
+		Users:         users,
+		NextPageToken: next,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
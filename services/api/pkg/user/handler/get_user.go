@@ -14,5 +14,8 @@ func (h *Handler) GetUser(c echo.Context) error {
 		return c.String(http.StatusInternalServerError, "failed fetch user "+err.Error())
 	}
 
+	// public profiles do not expose contact data
+	user.Email = ""
+
 	return c.JSON(http.StatusOK, user)
 }
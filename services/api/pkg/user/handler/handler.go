@@ -1,15 +1,69 @@
 package handler
 
-import "github.com/osamikoyo/music-and-marks/services/api/pkg/user/client"
+import (
+	"net/http"
+	"time"
 
-const RefreshTokenCookieName = "music-and-marks-refresh"
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/config"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/user/client"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+)
+
+const (
+	RefreshTokenCookieName = "music-and-marks-refresh"
+	RefreshTokenCookiePath = "/v1/auth"
+)
 
 type Handler struct {
-	cc *client.UserClient
+	cc     *client.UserClient
+	cookie config.CookieConfig
 }
 
-func NewHandler(cc *client.UserClient) *Handler {
+func NewHandler(cc *client.UserClient, cookie config.CookieConfig) *Handler {
 	return &Handler{
-		cc: cc,
+		cc:     cc,
+		cookie: cookie,
+	}
+}
+
+func (h *Handler) setRefreshCookie(c echo.Context, token string) {
+	c.SetCookie(&http.Cookie{
+		Name:     RefreshTokenCookieName,
+		Value:    token,
+		Path:     RefreshTokenCookiePath,
+		MaxAge:   int(h.cookie.RefreshTokenTTL / time.Second),
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func (h *Handler) clearRefreshCookie(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     RefreshTokenCookieName,
+		Path:     RefreshTokenCookiePath,
+		MaxAge:   -1,
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func clientInfo(c echo.Context) entity.ClientInfo {
+	return entity.ClientInfo{
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
+	}
+}
+
+// targetID resolves the account a request acts on: the :id path parameter
+// on admin routes and the caller everywhere else.
+func targetID(c echo.Context) string {
+	if id := c.Param("id"); id != "" {
+		return id
 	}
+
+	return auth.UserID(c)
 }
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListUsers(c echo.Context) error {
+	pageSize := 0
+
+	if raw := c.QueryParam("page_size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "failed convert page size")
+		}
+
+		pageSize = size
+	}
+
+	users, next, total, err := h.cc.ListUsers(c.Request().Context(), &pb.ListUsersRequest{
+		PageSize:       int32(pageSize),
+		PageToken:      c.QueryParam("page_token"),
+		UsernamePrefix: c.QueryParam("username_prefix"),
+		OrderBy:        c.QueryParam("order_by"),
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, status.Convert(err).Message())
+		}
+
+		return c.String(http.StatusInternalServerError, "failed list users "+err.Error())
+	}
+
+	// public listings do not expose contact data
+	for i := range users {
+		users[i].Email = ""
+	}
+
+	msg := struct {
+		Users         []entity.User `json:"users"`
+		NextPageToken string        `json:"next_page_token,omitempty"`
+		TotalSize     int           `json:"total_size"`
+	}{
+		Users:         users,
+		NextPageToken: next,
+		TotalSize:     total,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
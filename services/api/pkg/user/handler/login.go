@@ -1,9 +1,14 @@
 package handler
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) Login(c echo.Context) error {
@@ -16,17 +21,81 @@ func (h *Handler) Login(c echo.Context) error {
 		return c.String(http.StatusBadRequest, "failed bind request")
 	}
 
-	tokens, err := h.cc.Login(c.Request().Context(), req.Email, req.Password)
+	tokens, ticket, err := h.cc.Login(c.Request().Context(), req.Email, req.Password, clientInfo(c))
 	if err != nil {
-		return c.String(http.StatusInternalServerError, "failed login "+err.Error())
+		return h.loginError(c, err, "invalid email or password")
 	}
 
-	cookie := new(http.Cookie)
-	cookie.Name = RefreshTokenCookieName
-	cookie.Value = tokens.RefreshToken
-	cookie.HttpOnly = true
+	if len(ticket) > 0 {
+		msg := struct {
+			MFARequired bool   `json:"mfa_required"`
+			MFATicket   string `json:"mfa_ticket"`
+		}{
+			MFARequired: true,
+			MFATicket:   ticket,
+		}
 
-	c.SetCookie(cookie)
+		return c.JSON(http.StatusOK, msg)
+	}
+
+	h.setRefreshCookie(c, tokens.RefreshToken)
 
 	return c.JSON(http.StatusOK, tokens)
 }
+
+// VerifyMFA completes a login that answered with an mfa ticket.
+func (h *Handler) VerifyMFA(c echo.Context) error {
+	var req struct {
+		Ticket string `json:"mfa_ticket"`
+		Code   string `json:"code"`
+	}
+
+	if err := c.Bind(&req); err != nil || req.Ticket == "" || req.Code == "" {
+		return c.String(http.StatusBadRequest, "failed bind ticket and code")
+	}
+
+	tokens, err := h.cc.VerifyMFA(c.Request().Context(), req.Ticket, req.Code, clientInfo(c))
+	if err != nil {
+		return h.loginError(c, err, "invalid or expired code")
+	}
+
+	h.setRefreshCookie(c, tokens.RefreshToken)
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// loginError maps failures of both login steps; unauthorized is the
+// message for rejected credentials.
+func (h *Handler) loginError(c echo.Context, err error, unauthorized string) error {
+	st := status.Convert(err)
+
+	switch st.Code() {
+	case codes.Unauthenticated:
+		return c.String(http.StatusUnauthorized, unauthorized)
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, "missing credentials")
+	case codes.ResourceExhausted:
+		setRetryAfter(c, st)
+
+		return c.String(http.StatusTooManyRequests, "too many login attempts, try again later")
+	case codes.PermissionDenied:
+		setRetryAfter(c, st)
+
+		return c.String(http.StatusLocked, "account is temporarily locked")
+	}
+
+	return c.String(http.StatusInternalServerError, "failed login "+err.Error())
+}
+
+// setRetryAfter copies the RetryInfo detail of the status to the
+// Retry-After header.
+func setRetryAfter(c echo.Context, st *status.Status) {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := int(math.Ceil(info.GetRetryDelay().AsDuration().Seconds()))
+			c.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+
+			return
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) Logout(c echo.Context) error {
+	cookie, err := c.Cookie(RefreshTokenCookieName)
+
+	// headers are sent with the response, so the cookie is cleared first
+	h.clearRefreshCookie(c)
+
+	if err != nil {
+		return c.String(http.StatusOK, "logged out successfully")
+	}
+
+	if err := h.cc.Logout(c.Request().Context(), cookie.Value); err != nil {
+		return c.String(http.StatusInternalServerError, "failed logout "+err.Error())
+	}
+
+	return c.String(http.StatusOK, "logged out successfully")
+}
@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+)
+
+func (h *Handler) Me(c echo.Context) error {
+	user, err := h.cc.GetUser(c.Request().Context(), auth.UserID(c))
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed fetch user "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
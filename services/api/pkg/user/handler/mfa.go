@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mfaError(c echo.Context, err error, action string) error {
+	switch status.Code(err) {
+	case codes.FailedPrecondition:
+		return c.String(http.StatusConflict, status.Convert(err).Message())
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, "invalid code")
+	}
+
+	return c.String(http.StatusInternalServerError, "failed "+action+" "+err.Error())
+}
+
+// EnrollTOTP returns the secret, the provisioning URI and the recovery
+// codes; they are not shown again.
+func (h *Handler) EnrollTOTP(c echo.Context) error {
+	resp, err := h.cc.EnrollTOTP(c.Request().Context(), auth.UserID(c))
+	if err != nil {
+		return mfaError(c, err, "enroll totp")
+	}
+
+	msg := struct {
+		Secret          string   `json:"secret"`
+		ProvisioningURI string   `json:"provisioning_uri"`
+		RecoveryCodes   []string `json:"recovery_codes"`
+	}{
+		Secret:          resp.Secret,
+		ProvisioningURI: resp.ProvisioningUri,
+		RecoveryCodes:   resp.RecoveryCodes,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) ConfirmTOTP(c echo.Context) error {
+	var req struct {
+		Code string `json:"code"`
+	}
+
+	if err := c.Bind(&req); err != nil || req.Code == "" {
+		return c.String(http.StatusBadRequest, "failed bind code")
+	}
+
+	if err := h.cc.ConfirmTOTP(c.Request().Context(), auth.UserID(c), req.Code); err != nil {
+		return mfaError(c, err, "confirm totp")
+	}
+
+	return c.String(http.StatusOK, "two-factor authentication enabled")
+}
+
+func (h *Handler) DisableTOTP(c echo.Context) error {
+	var req struct {
+		Code string `json:"code"`
+	}
+
+	if err := c.Bind(&req); err != nil || req.Code == "" {
+		return c.String(http.StatusBadRequest, "failed bind code")
+	}
+
+	if err := h.cc.DisableTOTP(c.Request().Context(), auth.UserID(c), req.Code); err != nil {
+		return mfaError(c, err, "disable totp")
+	}
+
+	return c.String(http.StatusOK, "two-factor authentication disabled")
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	OIDCStateCookieName = "music-and-marks-oidc"
+	OIDCStateCookiePath = "/v1/auth/oidc"
+
+	oidcStateCookieTTL = 10 * time.Minute
+)
+
+// setStateCookie binds the flow to the browser that started it, so that a
+// callback URL crafted by someone else is rejected. Lax is needed because
+// the provider redirects back cross-site.
+func (h *Handler) setStateCookie(c echo.Context, state string) {
+	c.SetCookie(&http.Cookie{
+		Name:     OIDCStateCookieName,
+		Value:    state,
+		Path:     OIDCStateCookiePath,
+		MaxAge:   int(oidcStateCookieTTL / time.Second),
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (h *Handler) clearStateCookie(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     OIDCStateCookieName,
+		Path:     OIDCStateCookiePath,
+		MaxAge:   -1,
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// statusMessage is the description of the gRPC status in err, without
+// the context the client wrapped it in.
+func statusMessage(err error) string {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Message()
+	}
+
+	return err.Error()
+}
+
+func oidcError(c echo.Context, err error, action string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, statusMessage(err))
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, statusMessage(err))
+	case codes.Unauthenticated:
+		return c.String(http.StatusUnauthorized, statusMessage(err))
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return c.String(http.StatusConflict, statusMessage(err))
+	}
+
+	return c.String(http.StatusInternalServerError, "failed "+action+" "+err.Error())
+}
+
+// BeginOIDCLogin redirects the browser to the provider.
+func (h *Handler) BeginOIDCLogin(c echo.Context) error {
+	url, state, err := h.cc.BeginOIDC(c.Request().Context(), c.Param("provider"), "")
+	if err != nil {
+		return oidcError(c, err, "begin oidc login")
+	}
+
+	h.setStateCookie(c, state)
+
+	return c.Redirect(http.StatusFound, url)
+}
+
+// OIDCCallback is the redirect URL registered with the providers. It
+// finishes sign-ins like Login and links started by LinkIdentity.
+func (h *Handler) OIDCCallback(c echo.Context) error {
+	if reason := c.QueryParam("error"); reason != "" {
+		h.clearStateCookie(c)
+
+		return c.String(http.StatusUnauthorized, "identity provider returned "+reason)
+	}
+
+	state := c.QueryParam("state")
+	code := c.QueryParam("code")
+
+	cookie, err := c.Cookie(OIDCStateCookieName)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		return c.String(http.StatusBadRequest, "oidc state does not match this browser")
+	}
+
+	h.clearStateCookie(c)
+
+	tokens, ticket, linked, err := h.cc.CompleteOIDC(c.Request().Context(), c.Param("provider"), state, code, clientInfo(c))
+	if err != nil {
+		return oidcError(c, err, "complete oidc")
+	}
+
+	if linked != nil {
+		return c.JSON(http.StatusOK, linked)
+	}
+
+	if len(ticket) > 0 {
+		msg := struct {
+			MFARequired bool   `json:"mfa_required"`
+			MFATicket   string `json:"mfa_ticket"`
+		}{
+			MFARequired: true,
+			MFATicket:   ticket,
+		}
+
+		return c.JSON(http.StatusOK, msg)
+	}
+
+	h.setRefreshCookie(c, tokens.RefreshToken)
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// LinkIdentity starts linking a provider to the caller. It answers with
+// the URL to open instead of redirecting, since it is called with a
+// bearer token rather than navigated to.
+func (h *Handler) LinkIdentity(c echo.Context) error {
+	url, state, err := h.cc.BeginOIDC(c.Request().Context(), c.Param("provider"), auth.UserID(c))
+	if err != nil {
+		return oidcError(c, err, "link identity")
+	}
+
+	h.setStateCookie(c, state)
+
+	msg := struct {
+		AuthorizationURL string `json:"authorization_url"`
+	}{
+		AuthorizationURL: url,
+	}
+
+	return c.JSON(http.StatusOK, msg)
+}
+
+func (h *Handler) ListIdentities(c echo.Context) error {
+	identities, err := h.cc.ListIdentities(c.Request().Context(), auth.UserID(c))
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed list identities "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, identities)
+}
+
+func (h *Handler) UnlinkIdentity(c echo.Context) error {
+	if err := h.cc.UnlinkIdentity(c.Request().Context(), auth.UserID(c), c.Param("provider")); err != nil {
+		return oidcError(c, err, "unlink identity")
+	}
+
+	return c.String(http.StatusOK, "identity unlinked successfully")
+}
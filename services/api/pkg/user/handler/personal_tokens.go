@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePersonalToken returns the token once; only its hint is listed
+// afterwards.
+func (h *Handler) CreatePersonalToken(c echo.Context) error {
+	var req struct {
+		Name      string     `json:"name"`
+		Scopes    []string   `json:"scopes"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind personal token")
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	token, secret, err := h.cc.CreatePersonalToken(c.Request().Context(), auth.UserID(c), req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, "invalid name, scopes or expires_at")
+		case codes.ResourceExhausted:
+			return c.String(http.StatusConflict, "too many personal access tokens")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed create personal token "+err.Error())
+	}
+
+	msg := struct {
+		Token *entity.PersonalToken `json:"token"`
+		Value string                `json:"value"`
+	}{
+		Token: token,
+		Value: secret,
+	}
+
+	return c.JSON(http.StatusCreated, msg)
+}
+
+func (h *Handler) ListPersonalTokens(c echo.Context) error {
+	tokens, err := h.cc.ListPersonalTokens(c.Request().Context(), auth.UserID(c))
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed list personal tokens "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+func (h *Handler) RevokePersonalToken(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := h.cc.RevokePersonalToken(c.Request().Context(), auth.UserID(c), id); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.String(http.StatusNotFound, "personal token not found")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed revoke personal token "+err.Error())
+	}
+
+	return c.String(http.StatusOK, "personal token revoked successfully")
+}
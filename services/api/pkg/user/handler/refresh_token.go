@@ -9,20 +9,24 @@ import (
 func (h *Handler) RefreshToken(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	cookie, err := c.Cookie("music-and-marks-refresh")
+	cookie, err := c.Cookie(RefreshTokenCookieName)
 	if err != nil {
-		return c.String(http.StatusBadGateway, "not found refresh token")
+		return c.String(http.StatusUnauthorized, "not found refresh token")
 	}
 
-	token, err := h.cc.RefreshToken(ctx, cookie.Value)
+	tokens, err := h.cc.RefreshToken(ctx, cookie.Value)
 	if err != nil {
-		return c.String(http.StatusInternalServerError, "failed generate access token "+err.Error())
+		h.clearRefreshCookie(c)
+
+		return c.String(http.StatusUnauthorized, "failed refresh token "+err.Error())
 	}
 
+	h.setRefreshCookie(c, tokens.RefreshToken)
+
 	msg := struct {
 		Token string `json:"access_token"`
 	}{
-		Token: token,
+		Token: tokens.AccessToken,
 	}
 
 	return c.JSON(http.StatusOK, msg)
@@ -5,26 +5,33 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) Register(c echo.Context) error {
-	var user entity.User
+	var req struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Email    string `json:"email"`
+	}
 
-	if err := c.Bind(&user); err != nil {
+	if err := c.Bind(&req); err != nil {
 		return c.String(http.StatusBadRequest, "failed convert user")
 	}
 
-	tokens, err := h.cc.Register(c.Request().Context(), &user)
+	user := entity.NewUser(req.Username, req.Password, req.Email)
+
+	tokens, err := h.cc.Register(c.Request().Context(), user, clientInfo(c))
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, status.Convert(err).Message())
+		}
+
 		return c.String(http.StatusInternalServerError, "failed register user "+err.Error())
 	}
 
-	cookie := new(http.Cookie)
-	cookie.Name = RefreshTokenCookieName
-	cookie.Value = tokens.RefreshToken
-	cookie.HttpOnly = true
-
-	c.SetCookie(cookie)
+	h.setRefreshCookie(c, tokens.RefreshToken)
 
 	return c.JSON(http.StatusCreated, tokens)
 }
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestPasswordReset answers the same way whether or not the email is
+// registered.
+func (h *Handler) RequestPasswordReset(c echo.Context) error {
+	var req struct {
+		Email string `json:"email"`
+	}
+
+	if err := c.Bind(&req); err != nil || req.Email == "" {
+		return c.String(http.StatusBadRequest, "failed bind email")
+	}
+
+	if err := h.cc.RequestPasswordReset(c.Request().Context(), req.Email); err != nil {
+		return c.String(http.StatusInternalServerError, "failed request password reset "+err.Error())
+	}
+
+	return c.String(http.StatusAccepted, "if the email is registered, a reset link was sent")
+}
+
+func (h *Handler) ResetPassword(c echo.Context) error {
+	var req struct {
+		Token       string `json:"token"`
+		NewPassword string `json:"new_password"`
+	}
+
+	if err := c.Bind(&req); err != nil || req.Token == "" || req.NewPassword == "" {
+		return c.String(http.StatusBadRequest, "failed bind token and password")
+	}
+
+	if err := h.cc.ResetPassword(c.Request().Context(), req.Token, req.NewPassword); err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, status.Convert(err).Message())
+		}
+
+		return c.String(http.StatusInternalServerError, "failed reset password "+err.Error())
+	}
+
+	// every session was revoked, the refresh cookie is useless now
+	h.clearRefreshCookie(c)
+
+	return c.String(http.StatusOK, "password reset successfully")
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+)
+
+func (h *Handler) ListSessions(c echo.Context) error {
+	sessions, err := h.cc.ListSessions(c.Request().Context(), auth.UserID(c))
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed list sessions "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, sessions)
+}
+
+func (h *Handler) RevokeSession(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := h.cc.RevokeSession(c.Request().Context(), auth.UserID(c), id); err != nil {
+		return c.String(http.StatusInternalServerError, "failed revoke session "+err.Error())
+	}
+
+	return c.String(http.StatusOK, "session revoked successfully")
+}
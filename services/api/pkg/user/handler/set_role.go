@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) SetRole(c echo.Context) error {
+	id := c.Param("id")
+
+	var req struct {
+		Role string `json:"role"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind role")
+	}
+
+	if err := h.cc.SetUserRole(c.Request().Context(), id, req.Role); err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, "unknown role")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed set role "+err.Error())
+	}
+
+	return c.String(http.StatusOK, "role updated successfully")
+}
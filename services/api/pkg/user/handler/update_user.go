@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateUser changes only the fields present in the request body.
+func (h *Handler) UpdateUser(c echo.Context) error {
+	var req struct {
+		Username    *string `json:"username"`
+		Email       *string `json:"email"`
+		DisplayName *string `json:"display_name"`
+		Bio         *string `json:"bio"`
+		AvatarURL   *string `json:"avatar_url"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, "failed bind user")
+	}
+
+	var (
+		patch entity.User
+		paths []string
+	)
+
+	for _, field := range []struct {
+		path  string
+		value *string
+		dst   *string
+	}{
+		{"username", req.Username, &patch.Username},
+		{"email", req.Email, &patch.Email},
+		{"display_name", req.DisplayName, &patch.DisplayName},
+		{"bio", req.Bio, &patch.Bio},
+		{"avatar_url", req.AvatarURL, &patch.AvatarURL},
+	} {
+		if field.value != nil {
+			*field.dst = *field.value
+			paths = append(paths, field.path)
+		}
+	}
+
+	if len(paths) == 0 {
+		return c.String(http.StatusBadRequest, "nothing to update")
+	}
+
+	user, err := h.cc.UpdateUser(c.Request().Context(), targetID(c), &patch, paths)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.AlreadyExists:
+			return c.String(http.StatusConflict, status.Convert(err).Message())
+		case codes.InvalidArgument:
+			return c.String(http.StatusBadRequest, status.Convert(err).Message())
+		case codes.PermissionDenied:
+			return c.String(http.StatusForbidden, "permission denied")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed update user "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
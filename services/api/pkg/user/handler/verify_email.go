@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) SendVerificationEmail(c echo.Context) error {
+	if err := h.cc.SendVerificationEmail(c.Request().Context(), auth.UserID(c)); err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return c.String(http.StatusConflict, status.Convert(err).Message())
+		case codes.Unavailable:
+			return c.String(http.StatusServiceUnavailable, "failed send verification email, try again later")
+		}
+
+		return c.String(http.StatusInternalServerError, "failed send verification email "+err.Error())
+	}
+
+	return c.String(http.StatusAccepted, "verification email sent")
+}
+
+func (h *Handler) VerifyEmail(c echo.Context) error {
+	var req struct {
+		Token string `json:"token"`
+	}
+
+	if err := c.Bind(&req); err != nil || req.Token == "" {
+		return c.String(http.StatusBadRequest, "failed bind token")
+	}
+
+	user, err := h.cc.VerifyEmail(c.Request().Context(), req.Token)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.String(http.StatusBadRequest, status.Convert(err).Message())
+		}
+
+		return c.String(http.StatusInternalServerError, "failed verify email "+err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/labstack/echo/v4"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/api/config"
+	"github.com/osamikoyo/music-and-marks/services/api/pkg/auth"
 	markcore "github.com/osamikoyo/music-and-marks/services/api/pkg/mark/core"
 	musiccore "github.com/osamikoyo/music-and-marks/services/api/pkg/music/core"
 	usercore "github.com/osamikoyo/music-and-marks/services/api/pkg/user/core"
@@ -54,6 +56,15 @@ func SetupApiServer(config_path string) (*Server, error) {
 
 	e := echo.New()
 
+	// per-IP throttling must not trust addresses clients make up
+	if e.IPExtractor, err = ipExtractor(cfg.TrustedProxies); err != nil {
+		logger.Error("failed parse trusted proxies",
+			zap.Strings("trusted_proxies", cfg.TrustedProxies),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed parse trusted proxies: %w", err)
+	}
+
 	for _, core := range cores {
 		core.RegisterHandler(e)
 	}
@@ -65,6 +76,31 @@ func SetupApiServer(config_path string) (*Server, error) {
 	}, nil
 }
 
+// ipExtractor reads the client address from the connection, or from
+// X-Forwarded-For when the request passed through the trusted proxies.
+func ipExtractor(proxies []string) (echo.IPExtractor, error) {
+	if len(proxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, proxy := range proxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	s.logger.Info("starting server",
 		zap.String("addr", s.cfg.Addr))
@@ -88,7 +124,9 @@ func (s *Server) Close(ctx context.Context) error {
 func setupCores(cfg *config.Config, logger *logger.Logger) ([]Core, error) {
 	logger.Info("setup cores")
 
-	mark, err := markcore.SetupMarkCore(cfg, logger)
+	auth := auth.NewAuth(cfg, logger)
+
+	mark, err := markcore.SetupMarkCore(cfg, auth, logger)
 	if err != nil {
 		logger.Error("failed setup mark core",
 			zap.Error(err))
@@ -96,7 +134,7 @@ func setupCores(cfg *config.Config, logger *logger.Logger) ([]Core, error) {
 		return nil, fmt.Errorf("failed setup mark core: %w", err)
 	}
 
-	music, err := musiccore.SetupMusicCore(cfg, logger)
+	music, err := musiccore.SetupMusicCore(cfg, auth, logger)
 	if err != nil {
 		logger.Error("failed setup music core",
 			zap.Error(err))
@@ -104,7 +142,7 @@ func setupCores(cfg *config.Config, logger *logger.Logger) ([]Core, error) {
 		return nil, fmt.Errorf("failed setup music core: %w", err)
 	}
 
-	user, err := usercore.SetupUserCore(cfg, logger)
+	user, err := usercore.SetupUserCore(cfg, auth, logger)
 	if err != nil {
 		logger.Error("failed setup user core",
 			zap.Error(err))
@@ -112,10 +150,5 @@ func setupCores(cfg *config.Config, logger *logger.Logger) ([]Core, error) {
 		return nil, fmt.Errorf("failed setup user core: %w", err)
 	}
 
-	cores := make([]Core, 3)
-	cores[1] = mark
-	cores[2] = music
-	cores[3] = user
-
-	return cores, nil
+	return []Core{mark, music, user}, nil
 }
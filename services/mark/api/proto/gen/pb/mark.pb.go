@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,11 +24,18 @@ const (
 )
 
 type Mark struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ReleaseId     string                 `protobuf:"bytes,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
-	Value         float32                `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
-	Reviews       int32                  `protobuf:"varint,4,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReleaseId string                 `protobuf:"bytes,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// the mean score
+	Value   float32 `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reviews int32   `protobuf:"varint,4,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	Median  float32 `protobuf:"fixed32,5,opt,name=median,proto3" json:"median,omitempty"`
+	StdDev  float32 `protobuf:"fixed32,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	// histogram[i] is the number of reviews scoring i, from 0 to 10
+	Histogram []int64 `protobuf:"varint,7,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	// the Bayesian average against the mean of all reviews
+	Weighted      float32 `protobuf:"fixed32,8,opt,name=weighted,proto3" json:"weighted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,14 +98,48 @@ func (x *Mark) GetReviews() int32 {
 	return 0
 }
 
+func (x *Mark) GetMedian() float32 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *Mark) GetStdDev() float32 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *Mark) GetHistogram() []int64 {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *Mark) GetWeighted() float32 {
+	if x != nil {
+		return x.Weighted
+	}
+	return 0
+}
+
 type Review struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Likes         int64                  `protobuf:"varint,6,opt,name=likes,proto3" json:"likes,omitempty"`
-	ReleaseId     string                 `protobuf:"bytes,5,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Count     int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	UserId    string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Likes     int64                  `protobuf:"varint,6,opt,name=likes,proto3" json:"likes,omitempty"`
+	ReleaseId string                 `protobuf:"bytes,5,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	EditedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Comments  int32                  `protobuf:"varint,8,opt,name=comments,proto3" json:"comments,omitempty"`
+	// ISO 639 code of the text, empty when unknown
+	Language string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	// set while moderators hide the review
+	HiddenAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=hidden_at,json=hiddenAt,proto3" json:"hidden_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,27 +216,65 @@ func (x *Review) GetReleaseId() string {
 	return ""
 }
 
-type IncLikeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      uint32                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+func (x *Review) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Review) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *Review) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Review) GetHiddenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HiddenAt
+	}
+	return nil
+}
+
+type Comment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId uint64                 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// 0 for comments on the review itself
+	ParentId      uint64                 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Depth         int32                  `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	Replies       int32                  `protobuf:"varint,7,opt,name=replies,proto3" json:"replies,omitempty"`
+	Deleted       bool                   `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncLikeRequest) Reset() {
-	*x = IncLikeRequest{}
+func (x *Comment) Reset() {
+	*x = Comment{}
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncLikeRequest) String() string {
+func (x *Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncLikeRequest) ProtoMessage() {}
+func (*Comment) ProtoMessage() {}
 
-func (x *IncLikeRequest) ProtoReflect() protoreflect.Message {
+func (x *Comment) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,39 +286,107 @@ func (x *IncLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncLikeRequest.ProtoReflect.Descriptor instead.
-func (*IncLikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
 	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *IncLikeRequest) GetReviewId() uint32 {
+func (x *Comment) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetReviewId() uint64 {
 	if x != nil {
 		return x.ReviewId
 	}
 	return 0
 }
 
-type DecLikeRequest struct {
+func (x *Comment) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetReplies() int32 {
+	if x != nil {
+		return x.Replies
+	}
+	return 0
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type ReviewRevision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      uint32                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId      uint64                 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	WrittenAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=written_at,json=writtenAt,proto3" json:"written_at,omitempty"`
+	ReplacedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DecLikeRequest) Reset() {
-	*x = DecLikeRequest{}
+func (x *ReviewRevision) Reset() {
+	*x = ReviewRevision{}
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DecLikeRequest) String() string {
+func (x *ReviewRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecLikeRequest) ProtoMessage() {}
+func (*ReviewRevision) ProtoMessage() {}
 
-func (x *DecLikeRequest) ProtoReflect() protoreflect.Message {
+func (x *ReviewRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -250,39 +398,76 @@ func (x *DecLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecLikeRequest.ProtoReflect.Descriptor instead.
-func (*DecLikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviewRevision.ProtoReflect.Descriptor instead.
+func (*ReviewRevision) Descriptor() ([]byte, []int) {
 	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DecLikeRequest) GetReviewId() uint32 {
+func (x *ReviewRevision) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewRevision) GetReviewId() uint64 {
 	if x != nil {
 		return x.ReviewId
 	}
 	return 0
 }
 
-type GetMarkRequest struct {
+func (x *ReviewRevision) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ReviewRevision) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReviewRevision) GetWrittenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WrittenAt
+	}
+	return nil
+}
+
+func (x *ReviewRevision) GetReplacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return nil
+}
+
+type ReviewLike struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReleaseId     string                 `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReviewId      uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarkRequest) Reset() {
-	*x = GetMarkRequest{}
+func (x *ReviewLike) Reset() {
+	*x = ReviewLike{}
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarkRequest) String() string {
+func (x *ReviewLike) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarkRequest) ProtoMessage() {}
+func (*ReviewLike) ProtoMessage() {}
 
-func (x *GetMarkRequest) ProtoReflect() protoreflect.Message {
+func (x *ReviewLike) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,39 +479,54 @@ func (x *GetMarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarkRequest.ProtoReflect.Descriptor instead.
-func (*GetMarkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviewLike.ProtoReflect.Descriptor instead.
+func (*ReviewLike) Descriptor() ([]byte, []int) {
 	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetMarkRequest) GetReleaseId() string {
+func (x *ReviewLike) GetReviewId() uint64 {
 	if x != nil {
-		return x.ReleaseId
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewLike) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-type GetReviewsResponse struct {
+func (x *ReviewLike) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Liking is idempotent: repeated likes and unlikes leave the count as is.
+type LikeReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	ReviewId      uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReviewsResponse) Reset() {
-	*x = GetReviewsResponse{}
+func (x *LikeReviewRequest) Reset() {
+	*x = LikeReviewRequest{}
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReviewsResponse) String() string {
+func (x *LikeReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReviewsResponse) ProtoMessage() {}
+func (*LikeReviewRequest) ProtoMessage() {}
 
-func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
+func (x *LikeReviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -338,39 +538,39 @@ func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReviewsResponse.ProtoReflect.Descriptor instead.
-func (*GetReviewsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LikeReviewRequest.ProtoReflect.Descriptor instead.
+func (*LikeReviewRequest) Descriptor() ([]byte, []int) {
 	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetReviewsResponse) GetReviews() []*Review {
+func (x *LikeReviewRequest) GetReviewId() uint64 {
 	if x != nil {
-		return x.Reviews
+		return x.ReviewId
 	}
-	return nil
+	return 0
 }
 
-type GetReviewsRequest struct {
+type LikeReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReleaseId     string                 `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Likes         int64                  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReviewsRequest) Reset() {
-	*x = GetReviewsRequest{}
+func (x *LikeReviewResponse) Reset() {
+	*x = LikeReviewResponse{}
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReviewsRequest) String() string {
+func (x *LikeReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReviewsRequest) ProtoMessage() {}
+func (*LikeReviewResponse) ProtoMessage() {}
 
-func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
+func (x *LikeReviewResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,39 +582,39 @@ func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReviewsRequest.ProtoReflect.Descriptor instead.
-func (*GetReviewsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LikeReviewResponse.ProtoReflect.Descriptor instead.
+func (*LikeReviewResponse) Descriptor() ([]byte, []int) {
 	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetReviewsRequest) GetReleaseId() string {
+func (x *LikeReviewResponse) GetLikes() int64 {
 	if x != nil {
-		return x.ReleaseId
+		return x.Likes
 	}
-	return ""
+	return 0
 }
 
-type DeleteReviewRequest struct {
+type UnlikeReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId      uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReviewRequest) Reset() {
-	*x = DeleteReviewRequest{}
+func (x *UnlikeReviewRequest) Reset() {
+	*x = UnlikeReviewRequest{}
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReviewRequest) String() string {
+func (x *UnlikeReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReviewRequest) ProtoMessage() {}
+func (*UnlikeReviewRequest) ProtoMessage() {}
 
-func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+func (x *UnlikeReviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_mark_api_proto_mark_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,59 +626,3771 @@ func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlikeReviewRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeReviewRequest) Descriptor() ([]byte, []int) {
 	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteReviewRequest) GetId() uint64 {
+func (x *UnlikeReviewRequest) GetReviewId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ReviewId
 	}
 	return 0
 }
 
-var File_services_mark_api_proto_mark_proto protoreflect.FileDescriptor
+type UnlikeReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         int64                  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_services_mark_api_proto_mark_proto_rawDesc = "" +
-	"\n" +
-	"\"services/mark/api/proto/mark.proto\x1a\x1bgoogle/protobuf/empty.proto\"e\n" +
-	"\x04Mark\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
-	"\n" +
-	"release_id\x18\x02 \x01(\tR\treleaseId\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\x02R\x05value\x12\x18\n" +
-	"\areviews\x18\x04 \x01(\x05R\areviews\"\x90\x01\n" +
-	"\x06Review\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05likes\x18\x06 \x01(\x03R\x05likes\x12\x1d\n" +
-	"\n" +
-	"release_id\x18\x05 \x01(\tR\treleaseId\"-\n" +
-	"\x0eIncLikeRequest\x12\x1b\n" +
-	"\treview_id\x18\x01 \x01(\rR\breviewId\"-\n" +
-	"\x0eDecLikeRequest\x12\x1b\n" +
-	"\treview_id\x18\x01 \x01(\rR\breviewId\"/\n" +
-	"\x0eGetMarkRequest\x12\x1d\n" +
-	"\n" +
-	"release_id\x18\x01 \x01(\tR\treleaseId\"7\n" +
-	"\x12GetReviewsResponse\x12!\n" +
-	"\areviews\x18\x01 \x03(\v2\a.ReviewR\areviews\"2\n" +
-	"\x11GetReviewsRequest\x12\x1d\n" +
-	"\n" +
-	"release_id\x18\x01 \x01(\tR\treleaseId\"%\n" +
-	"\x13DeleteReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id2\xbe\x02\n" +
-	"\vMarkService\x125\n" +
-	"\n" +
-	"GetReviews\x12\x12.GetReviewsRequest\x1a\x13.GetReviewsResponse\x12<\n" +
-	"\fDeleteReview\x12\x14.DeleteReviewRequest\x1a\x16.google.protobuf.Empty\x12!\n" +
-	"\aGetMark\x12\x0f.GetMarkRequest\x1a\x05.Mark\x12/\n" +
-	"\fCreateReview\x12\a.Review\x1a\x16.google.protobuf.Empty\x122\n" +
-	"\aIncLike\x12\x0f.IncLikeRequest\x1a\x16.google.protobuf.Empty\x122\n" +
-	"\aDecLike\x12\x0f.DecLikeRequest\x1a\x16.google.protobuf.EmptyB\"Z ./services/mark/api/proto/gen/pbb\x06proto3"
+func (x *UnlikeReviewResponse) Reset() {
+	*x = UnlikeReviewResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeReviewResponse) ProtoMessage() {}
+
+func (x *UnlikeReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeReviewResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeReviewResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnlikeReviewResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+type ListReviewLikersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewLikersRequest) Reset() {
+	*x = ListReviewLikersRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewLikersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewLikersRequest) ProtoMessage() {}
+
+func (x *ListReviewLikersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewLikersRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewLikersRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListReviewLikersRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ListReviewLikersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewLikersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReviewLikersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likers        []*ReviewLike          `protobuf:"bytes,1,rep,name=likers,proto3" json:"likers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewLikersResponse) Reset() {
+	*x = ListReviewLikersResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewLikersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewLikersResponse) ProtoMessage() {}
+
+func (x *ListReviewLikersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewLikersResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewLikersResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListReviewLikersResponse) GetLikers() []*ReviewLike {
+	if x != nil {
+		return x.Likers
+	}
+	return nil
+}
+
+func (x *ListReviewLikersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId     string                 `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkRequest) Reset() {
+	*x = GetMarkRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkRequest) ProtoMessage() {}
+
+func (x *GetMarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkRequest.ProtoReflect.Descriptor instead.
+func (*GetMarkRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMarkRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+type GetReleaseGroupMarkRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseGroupId string                 `protobuf:"bytes,1,opt,name=release_group_id,json=releaseGroupId,proto3" json:"release_group_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReleaseGroupMarkRequest) Reset() {
+	*x = GetReleaseGroupMarkRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReleaseGroupMarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseGroupMarkRequest) ProtoMessage() {}
+
+func (x *GetReleaseGroupMarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseGroupMarkRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseGroupMarkRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReleaseGroupMarkRequest) GetReleaseGroupId() string {
+	if x != nil {
+		return x.ReleaseGroupId
+	}
+	return ""
+}
+
+type GetArtistMarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtistId      string                 `protobuf:"bytes,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArtistMarkRequest) Reset() {
+	*x = GetArtistMarkRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtistMarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistMarkRequest) ProtoMessage() {}
+
+func (x *GetArtistMarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistMarkRequest.ProtoReflect.Descriptor instead.
+func (*GetArtistMarkRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetArtistMarkRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+// ListMarksRequest pages through the marks of reviewed releases. order_by
+// is "weighted", "value" or "reviews" with an optional " desc" suffix, the
+// highest weighted marks come first when it is empty.
+type ListMarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderBy       string                 `protobuf:"bytes,1,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	MinReviews    int32                  `protobuf:"varint,2,opt,name=min_reviews,json=minReviews,proto3" json:"min_reviews,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarksRequest) Reset() {
+	*x = ListMarksRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarksRequest) ProtoMessage() {}
+
+func (x *ListMarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarksRequest.ProtoReflect.Descriptor instead.
+func (*ListMarksRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListMarksRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMarksRequest) GetMinReviews() int32 {
+	if x != nil {
+		return x.MinReviews
+	}
+	return 0
+}
+
+func (x *ListMarksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMarksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMarksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Marks         []*Mark                `protobuf:"bytes,1,rep,name=marks,proto3" json:"marks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarksResponse) Reset() {
+	*x = ListMarksResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarksResponse) ProtoMessage() {}
+
+func (x *ListMarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarksResponse.ProtoReflect.Descriptor instead.
+func (*ListMarksResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMarksResponse) GetMarks() []*Mark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+func (x *ListMarksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewsResponse) Reset() {
+	*x = GetReviewsResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsResponse) ProtoMessage() {}
+
+func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *GetReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetReviewsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId string                 `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// "text" (the default) for reviews with text, "score" for score-only
+	// ratings or "all"
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// "created_at", "likes" or "score" with an optional " desc" suffix,
+	// the newest reviews come first when it is empty
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// inclusive, 0 and 10 when unset
+	MinScore      *int32 `protobuf:"varint,4,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"`
+	MaxScore      *int32 `protobuf:"varint,5,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"`
+	Language      string `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	PageSize      int32  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewsRequest) Reset() {
+	*x = GetReviewsRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsRequest) ProtoMessage() {}
+
+func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetReviewsRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *GetReviewsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetReviewsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetReviewsRequest) GetMinScore() int32 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+func (x *GetReviewsRequest) GetMaxScore() int32 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *GetReviewsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type RateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId     string                 `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateRequest) Reset() {
+	*x = RateRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateRequest) ProtoMessage() {}
+
+func (x *RateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateRequest.ProtoReflect.Descriptor instead.
+func (*RateRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RateRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *RateRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type UpdateReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text  string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Count int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// the language is kept when empty
+	Language      string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateReviewRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ListReviewRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewRevisionsRequest) Reset() {
+	*x = ListReviewRevisionsRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewRevisionsRequest) ProtoMessage() {}
+
+func (x *ListReviewRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListReviewRevisionsRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type ListReviewRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ReviewRevision      `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewRevisionsResponse) Reset() {
+	*x = ListReviewRevisionsResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewRevisionsResponse) ProtoMessage() {}
+
+func (x *ListReviewRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListReviewRevisionsResponse) GetRevisions() []*ReviewRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DeleteReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the caller is taken from the forwarded access token
+	//
+	// Deprecated: Marked as deprecated in services/mark/api/proto/mark.proto.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteReviewRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in services/mark/api/proto/mark.proto.
+func (x *DeleteReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateCommentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// replies to this comment when set
+	ParentId      uint64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Text          string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateCommentRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EditCommentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteCommentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListCommentsRequest pages through the comments on a review, or the
+// replies to parent_id, oldest first. Every comment is followed by its
+// replies down to depth levels below it, 2 when unset. At most 10 replies
+// are listed under a comment; the rest are paged with it as parent_id.
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	ParentId      uint64                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Depth         *int32                 `protobuf:"varint,3,opt,name=depth,proto3,oneof" json:"depth,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCommentsRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetDepth() int32 {
+	if x != nil && x.Depth != nil {
+		return *x.Depth
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ReportReviewRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// one of spam, abuse, off_topic, spoiler or other
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details       string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportReviewRequest) Reset() {
+	*x = ReportReviewRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportReviewRequest) ProtoMessage() {}
+
+func (x *ReportReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReportReviewRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReportReviewRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReportReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportReviewRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ModerationItem struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Review *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	// open reports by distinct users
+	Reports int64 `protobuf:"varint,2,opt,name=reports,proto3" json:"reports,omitempty"`
+	// open reports by reason
+	Reasons         map[string]int64       `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	FirstReportedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_reported_at,json=firstReportedAt,proto3" json:"first_reported_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerationItem) Reset() {
+	*x = ModerationItem{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationItem) ProtoMessage() {}
+
+func (x *ModerationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationItem.ProtoReflect.Descriptor instead.
+func (*ModerationItem) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ModerationItem) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetReports() int64 {
+	if x != nil {
+		return x.Reports
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetReasons() map[string]int64 {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetFirstReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstReportedAt
+	}
+	return nil
+}
+
+type ListModerationQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListModerationQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListModerationQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ModerationItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueResponse) Reset() {
+	*x = ListModerationQueueResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListModerationQueueResponse) GetItems() []*ModerationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ModerateReviewRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId uint64                 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// kept in the moderation log
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ModerateReviewRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resolved      int64                  `protobuf:"varint,1,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsResponse) Reset() {
+	*x = ResolveReportsResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsResponse) ProtoMessage() {}
+
+func (x *ResolveReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportsResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResolveReportsResponse) GetResolved() int64 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
+}
+
+type ChartEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the place in the whole chart
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// the place among the entries matching the filters
+	Position      int32   `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	ReleaseId     string  `protobuf:"bytes,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Weighted      float32 `protobuf:"fixed32,4,opt,name=weighted,proto3" json:"weighted,omitempty"`
+	Value         float32 `protobuf:"fixed32,5,opt,name=value,proto3" json:"value,omitempty"`
+	Reviews       int32   `protobuf:"varint,6,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartEntry) Reset() {
+	*x = ChartEntry{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartEntry) ProtoMessage() {}
+
+func (x *ChartEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartEntry.ProtoReflect.Descriptor instead.
+func (*ChartEntry) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChartEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ChartEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChartEntry) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *ChartEntry) GetWeighted() float32 {
+	if x != nil {
+		return x.Weighted
+	}
+	return 0
+}
+
+func (x *ChartEntry) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ChartEntry) GetReviews() int32 {
+	if x != nil {
+		return x.Reviews
+	}
+	return 0
+}
+
+// GetChartRequest names a chart by period: all_time (the default), year or
+// decade of release, which need a year, or week or month of reviews for
+// trending releases. Genre, country and primary type filter the chart by
+// the catalog metadata of releases; a filtered listing is the top of the
+// matching releases, however far down the chart they rank.
+type GetChartRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Period string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Year   int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Genre  string                 `protobuf:"bytes,3,opt,name=genre,proto3" json:"genre,omitempty"`
+	// ISO 3166 code of the release country
+	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	// album, single, ep...
+	PrimaryType   string `protobuf:"bytes,5,opt,name=primary_type,json=primaryType,proto3" json:"primary_type,omitempty"`
+	PageSize      int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartRequest) Reset() {
+	*x = GetChartRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartRequest) ProtoMessage() {}
+
+func (x *GetChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartRequest.ProtoReflect.Descriptor instead.
+func (*GetChartRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetChartRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetChartRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetChartRequest) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *GetChartRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GetChartRequest) GetPrimaryType() string {
+	if x != nil {
+		return x.PrimaryType
+	}
+	return ""
+}
+
+func (x *GetChartRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetChartRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ChartEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// when the chart was last rebuilt
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartResponse) Reset() {
+	*x = GetChartResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartResponse) ProtoMessage() {}
+
+func (x *GetChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartResponse.ProtoReflect.Descriptor instead.
+func (*GetChartResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetChartResponse) GetEntries() []*ChartEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetChartResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetChartResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type DiaryEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReleaseId string                 `protobuf:"bytes,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// "2025-03-14"
+	ListenedOn string `protobuf:"bytes,4,opt,name=listened_on,json=listenedOn,proto3" json:"listened_on,omitempty"`
+	Score      *int32 `protobuf:"varint,5,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	// the review the entry links to, 0 for none
+	ReviewId      uint64                 `protobuf:"varint,6,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Relisten      bool                   `protobuf:"varint,7,opt,name=relisten,proto3" json:"relisten,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiaryEntry) Reset() {
+	*x = DiaryEntry{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiaryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiaryEntry) ProtoMessage() {}
+
+func (x *DiaryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiaryEntry.ProtoReflect.Descriptor instead.
+func (*DiaryEntry) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DiaryEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DiaryEntry) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *DiaryEntry) GetListenedOn() string {
+	if x != nil {
+		return x.ListenedOn
+	}
+	return ""
+}
+
+func (x *DiaryEntry) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetRelisten() bool {
+	if x != nil {
+		return x.Relisten
+	}
+	return false
+}
+
+func (x *DiaryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DiaryEntry) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type CreateDiaryEntryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId string                 `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// "2025-03-14", today when unset
+	ListenedOn string `protobuf:"bytes,2,opt,name=listened_on,json=listenedOn,proto3" json:"listened_on,omitempty"`
+	Score      *int32 `protobuf:"varint,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	// links one of the caller's reviews of the release
+	ReviewId      uint64 `protobuf:"varint,4,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Relisten      bool   `protobuf:"varint,5,opt,name=relisten,proto3" json:"relisten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDiaryEntryRequest) Reset() {
+	*x = CreateDiaryEntryRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDiaryEntryRequest) ProtoMessage() {}
+
+func (x *CreateDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateDiaryEntryRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *CreateDiaryEntryRequest) GetListenedOn() string {
+	if x != nil {
+		return x.ListenedOn
+	}
+	return ""
+}
+
+func (x *CreateDiaryEntryRequest) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *CreateDiaryEntryRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *CreateDiaryEntryRequest) GetRelisten() bool {
+	if x != nil {
+		return x.Relisten
+	}
+	return false
+}
+
+// EditDiaryEntryRequest replaces the date, score, review and relisten flag
+// of an entry; the release stays.
+type EditDiaryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListenedOn    string                 `protobuf:"bytes,2,opt,name=listened_on,json=listenedOn,proto3" json:"listened_on,omitempty"`
+	Score         *int32                 `protobuf:"varint,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	ReviewId      uint64                 `protobuf:"varint,4,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Relisten      bool                   `protobuf:"varint,5,opt,name=relisten,proto3" json:"relisten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditDiaryEntryRequest) Reset() {
+	*x = EditDiaryEntryRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditDiaryEntryRequest) ProtoMessage() {}
+
+func (x *EditDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*EditDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EditDiaryEntryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditDiaryEntryRequest) GetListenedOn() string {
+	if x != nil {
+		return x.ListenedOn
+	}
+	return ""
+}
+
+func (x *EditDiaryEntryRequest) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *EditDiaryEntryRequest) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *EditDiaryEntryRequest) GetRelisten() bool {
+	if x != nil {
+		return x.Relisten
+	}
+	return false
+}
+
+type DeleteDiaryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDiaryEntryRequest) Reset() {
+	*x = DeleteDiaryEntryRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiaryEntryRequest) ProtoMessage() {}
+
+func (x *DeleteDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteDiaryEntryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListDiaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the caller when unset
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year   int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	// 1 to 12, the whole year when unset
+	Month         int32  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiaryRequest) Reset() {
+	*x = ListDiaryRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiaryRequest) ProtoMessage() {}
+
+func (x *ListDiaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiaryRequest.ProtoReflect.Descriptor instead.
+func (*ListDiaryRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDiaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDiaryRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ListDiaryRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *ListDiaryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDiaryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDiaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DiaryEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiaryResponse) Reset() {
+	*x = ListDiaryResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiaryResponse) ProtoMessage() {}
+
+func (x *ListDiaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiaryResponse.ProtoReflect.Descriptor instead.
+func (*ListDiaryResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListDiaryResponse) GetEntries() []*DiaryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListDiaryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDiaryStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the caller when unset
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year          int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiaryStatsRequest) Reset() {
+	*x = GetDiaryStatsRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiaryStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiaryStatsRequest) ProtoMessage() {}
+
+func (x *GetDiaryStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiaryStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiaryStatsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDiaryStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDiaryStatsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type ArtistListens struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtistId      string                 `protobuf:"bytes,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Entries       int64                  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArtistListens) Reset() {
+	*x = ArtistListens{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtistListens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistListens) ProtoMessage() {}
+
+func (x *ArtistListens) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistListens.ProtoReflect.Descriptor instead.
+func (*ArtistListens) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ArtistListens) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *ArtistListens) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+type DiaryStats struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Year    int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Entries int64                  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	// distinct releases listened to
+	Releases  int64 `protobuf:"varint,3,opt,name=releases,proto3" json:"releases,omitempty"`
+	Relistens int64 `protobuf:"varint,4,opt,name=relistens,proto3" json:"relistens,omitempty"`
+	// of the scored entries, unset when there are none
+	AverageScore *float32 `protobuf:"fixed32,5,opt,name=average_score,json=averageScore,proto3,oneof" json:"average_score,omitempty"`
+	// most logged artists first
+	TopArtists    []*ArtistListens `protobuf:"bytes,6,rep,name=top_artists,json=topArtists,proto3" json:"top_artists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiaryStats) Reset() {
+	*x = DiaryStats{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiaryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiaryStats) ProtoMessage() {}
+
+func (x *DiaryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiaryStats.ProtoReflect.Descriptor instead.
+func (*DiaryStats) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DiaryStats) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DiaryStats) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *DiaryStats) GetReleases() int64 {
+	if x != nil {
+		return x.Releases
+	}
+	return 0
+}
+
+func (x *DiaryStats) GetRelistens() int64 {
+	if x != nil {
+		return x.Relistens
+	}
+	return 0
+}
+
+func (x *DiaryStats) GetAverageScore() float32 {
+	if x != nil && x.AverageScore != nil {
+		return *x.AverageScore
+	}
+	return 0
+}
+
+func (x *DiaryStats) GetTopArtists() []*ArtistListens {
+	if x != nil {
+		return x.TopArtists
+	}
+	return nil
+}
+
+type List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Public        bool                   `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`
+	Items         int32                  `protobuf:"varint,6,opt,name=items,proto3" json:"items,omitempty"`
+	Likes         int64                  `protobuf:"varint,7,opt,name=likes,proto3" json:"likes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *List) Reset() {
+	*x = List{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*List) ProtoMessage() {}
+
+func (x *List) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use List.ProtoReflect.Descriptor instead.
+func (*List) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *List) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *List) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *List) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *List) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *List) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *List) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *List) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *List) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type ListItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListId    uint64                 `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ReleaseId string                 `protobuf:"bytes,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// from 1, in the order of the list
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItem) Reset() {
+	*x = ListItem{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItem) ProtoMessage() {}
+
+func (x *ListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
+func (*ListItem) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListItem) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *ListItem) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *ListItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ListItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ListItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Public        bool                   `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListRequest) Reset() {
+	*x = CreateListRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListRequest) ProtoMessage() {}
+
+func (x *CreateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListRequest.ProtoReflect.Descriptor instead.
+func (*CreateListRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+// UpdateListRequest replaces the name, description and visibility of a
+// list.
+type UpdateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Public        bool                   `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListRequest) Reset() {
+	*x = UpdateListRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListRequest) ProtoMessage() {}
+
+func (x *UpdateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type DeleteListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListRequest) Reset() {
+	*x = DeleteListRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListRequest) ProtoMessage() {}
+
+func (x *DeleteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListRequest) Reset() {
+	*x = GetListRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListRequest) ProtoMessage() {}
+
+func (x *GetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListRequest.ProtoReflect.Descriptor instead.
+func (*GetListRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BrowseListsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the lists of one user, private ones included for the user
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// only the lists holding the release
+	ReleaseId string `protobuf:"bytes,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// "created_at" or "likes", "created_at" when unset
+	OrderBy       string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowseListsRequest) Reset() {
+	*x = BrowseListsRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowseListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseListsRequest) ProtoMessage() {}
+
+func (x *BrowseListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseListsRequest.ProtoReflect.Descriptor instead.
+func (*BrowseListsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BrowseListsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BrowseListsRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *BrowseListsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *BrowseListsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BrowseListsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type BrowseListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*List                `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowseListsResponse) Reset() {
+	*x = BrowseListsResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowseListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseListsResponse) ProtoMessage() {}
+
+func (x *BrowseListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseListsResponse.ProtoReflect.Descriptor instead.
+func (*BrowseListsResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BrowseListsResponse) GetLists() []*List {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *BrowseListsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddListItemRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ListId    uint64                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ReleaseId string                 `protobuf:"bytes,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Note      string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	// the end of the list when unset
+	Position      int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddListItemRequest) Reset() {
+	*x = AddListItemRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListItemRequest) ProtoMessage() {}
+
+func (x *AddListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListItemRequest.ProtoReflect.Descriptor instead.
+func (*AddListItemRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddListItemRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *AddListItemRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+func (x *AddListItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AddListItemRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type EditListItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditListItemRequest) Reset() {
+	*x = EditListItemRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditListItemRequest) ProtoMessage() {}
+
+func (x *EditListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditListItemRequest.ProtoReflect.Descriptor instead.
+func (*EditListItemRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EditListItemRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditListItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type MoveListItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// positions past the end move the item to the end
+	Position      int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveListItemRequest) Reset() {
+	*x = MoveListItemRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveListItemRequest) ProtoMessage() {}
+
+func (x *MoveListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveListItemRequest.ProtoReflect.Descriptor instead.
+func (*MoveListItemRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MoveListItemRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveListItemRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type RemoveListItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveListItemRequest) Reset() {
+	*x = RemoveListItemRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveListItemRequest) ProtoMessage() {}
+
+func (x *RemoveListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveListItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveListItemRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RemoveListItemRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetListItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint64                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListItemsRequest) Reset() {
+	*x = GetListItemsRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListItemsRequest) ProtoMessage() {}
+
+func (x *GetListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetListItemsRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *GetListItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetListItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ListItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListItemsResponse) Reset() {
+	*x = GetListItemsResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListItemsResponse) ProtoMessage() {}
+
+func (x *GetListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetListItemsResponse) GetItems() []*ListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetListItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Liking is idempotent, the same as for reviews.
+type LikeListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint64                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeListRequest) Reset() {
+	*x = LikeListRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeListRequest) ProtoMessage() {}
+
+func (x *LikeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeListRequest.ProtoReflect.Descriptor instead.
+func (*LikeListRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LikeListRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+type LikeListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         int64                  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeListResponse) Reset() {
+	*x = LikeListResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeListResponse) ProtoMessage() {}
+
+func (x *LikeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeListResponse.ProtoReflect.Descriptor instead.
+func (*LikeListResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LikeListResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+type UnlikeListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint64                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeListRequest) Reset() {
+	*x = UnlikeListRequest{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeListRequest) ProtoMessage() {}
+
+func (x *UnlikeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeListRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeListRequest) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UnlikeListRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+type UnlikeListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         int64                  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeListResponse) Reset() {
+	*x = UnlikeListResponse{}
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeListResponse) ProtoMessage() {}
+
+func (x *UnlikeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_mark_api_proto_mark_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeListResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeListResponse) Descriptor() ([]byte, []int) {
+	return file_services_mark_api_proto_mark_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UnlikeListResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+var File_services_mark_api_proto_mark_proto protoreflect.FileDescriptor
+
+const file_services_mark_api_proto_mark_proto_rawDesc = "" +
+	"\n" +
+	"\"services/mark/api/proto/mark.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x01\n" +
+	"\x04Mark\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x02 \x01(\tR\treleaseId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x02R\x05value\x12\x18\n" +
+	"\areviews\x18\x04 \x01(\x05R\areviews\x12\x16\n" +
+	"\x06median\x18\x05 \x01(\x02R\x06median\x12\x17\n" +
+	"\astd_dev\x18\x06 \x01(\x02R\x06stdDev\x12\x1c\n" +
+	"\thistogram\x18\a \x03(\x03R\thistogram\x12\x1a\n" +
+	"\bweighted\x18\b \x01(\x02R\bweighted\"\xba\x02\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05likes\x18\x06 \x01(\x03R\x05likes\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x05 \x01(\tR\treleaseId\x127\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x1a\n" +
+	"\bcomments\x18\b \x01(\x05R\bcomments\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x127\n" +
+	"\thidden_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bhiddenAt\"\xbe\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x04R\breviewId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x04R\bparentId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x14\n" +
+	"\x05depth\x18\x06 \x01(\x05R\x05depth\x12\x18\n" +
+	"\areplies\x18\a \x01(\x05R\areplies\x12\x18\n" +
+	"\adeleted\x18\b \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xdf\x01\n" +
+	"\x0eReviewRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x04R\breviewId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x129\n" +
+	"\n" +
+	"written_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\twrittenAt\x12;\n" +
+	"\vreplaced_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replacedAt\"}\n" +
+	"\n" +
+	"ReviewLike\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"0\n" +
+	"\x11LikeReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\"*\n" +
+	"\x12LikeReviewResponse\x12\x14\n" +
+	"\x05likes\x18\x01 \x01(\x03R\x05likes\"2\n" +
+	"\x13UnlikeReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\",\n" +
+	"\x14UnlikeReviewResponse\x12\x14\n" +
+	"\x05likes\x18\x01 \x01(\x03R\x05likes\"r\n" +
+	"\x17ListReviewLikersRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"g\n" +
+	"\x18ListReviewLikersResponse\x12#\n" +
+	"\x06likers\x18\x01 \x03(\v2\v.ReviewLikeR\x06likers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
+	"\x0eGetMarkRequest\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x01 \x01(\tR\treleaseId\"F\n" +
+	"\x1aGetReleaseGroupMarkRequest\x12(\n" +
+	"\x10release_group_id\x18\x01 \x01(\tR\x0ereleaseGroupId\"3\n" +
+	"\x14GetArtistMarkRequest\x12\x1b\n" +
+	"\tartist_id\x18\x01 \x01(\tR\bartistId\"\x8a\x01\n" +
+	"\x10ListMarksRequest\x12\x19\n" +
+	"\border_by\x18\x01 \x01(\tR\aorderBy\x12\x1f\n" +
+	"\vmin_reviews\x18\x02 \x01(\x05R\n" +
+	"minReviews\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"X\n" +
+	"\x11ListMarksResponse\x12\x1b\n" +
+	"\x05marks\x18\x01 \x03(\v2\x05.MarkR\x05marks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"_\n" +
+	"\x12GetReviewsResponse\x12!\n" +
+	"\areviews\x18\x01 \x03(\v2\a.ReviewR\areviews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x99\x02\n" +
+	"\x11GetReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x01 \x01(\tR\treleaseId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12 \n" +
+	"\tmin_score\x18\x04 \x01(\x05H\x00R\bminScore\x88\x01\x01\x12 \n" +
+	"\tmax_score\x18\x05 \x01(\x05H\x01R\bmaxScore\x88\x01\x01\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageTokenB\f\n" +
+	"\n" +
+	"_min_scoreB\f\n" +
+	"\n" +
+	"_max_score\"B\n" +
+	"\vRateRequest\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x01 \x01(\tR\treleaseId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\"k\n" +
+	"\x13UpdateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\"9\n" +
+	"\x1aListReviewRevisionsRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\"L\n" +
+	"\x1bListReviewRevisionsResponse\x12-\n" +
+	"\trevisions\x18\x01 \x03(\v2\x0f.ReviewRevisionR\trevisions\"B\n" +
+	"\x13DeleteReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\tB\x02\x18\x01R\x06userId\"d\n" +
+	"\x14CreateCommentRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x04R\bparentId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"8\n" +
+	"\x12EditCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xb0\x01\n" +
+	"\x13ListCommentsRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x04R\bparentId\x12\x19\n" +
+	"\x05depth\x18\x03 \x01(\x05H\x00R\x05depth\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageTokenB\b\n" +
+	"\x06_depth\"d\n" +
+	"\x14ListCommentsResponse\x12$\n" +
+	"\bcomments\x18\x01 \x03(\v2\b.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x13ReportReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\adetails\x18\x03 \x01(\tR\adetails\"\x87\x02\n" +
+	"\x0eModerationItem\x12\x1f\n" +
+	"\x06review\x18\x01 \x01(\v2\a.ReviewR\x06review\x12\x18\n" +
+	"\areports\x18\x02 \x01(\x03R\areports\x126\n" +
+	"\areasons\x18\x03 \x03(\v2\x1c.ModerationItem.ReasonsEntryR\areasons\x12F\n" +
+	"\x11first_reported_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0ffirstReportedAt\x1a:\n" +
+	"\fReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"X\n" +
+	"\x1aListModerationQueueRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"l\n" +
+	"\x1bListModerationQueueResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.ModerationItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x15ModerateReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x04R\breviewId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"4\n" +
+	"\x16ResolveReportsResponse\x12\x1a\n" +
+	"\bresolved\x18\x01 \x01(\x03R\bresolved\"\xa7\x01\n" +
+	"\n" +
+	"ChartEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x03 \x01(\tR\treleaseId\x12\x1a\n" +
+	"\bweighted\x18\x04 \x01(\x02R\bweighted\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x02R\x05value\x12\x18\n" +
+	"\areviews\x18\x06 \x01(\x05R\areviews\"\xcc\x01\n" +
+	"\x0fGetChartRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05genre\x18\x03 \x01(\tR\x05genre\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12!\n" +
+	"\fprimary_type\x18\x05 \x01(\tR\vprimaryType\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\x9e\x01\n" +
+	"\x10GetChartResponse\x12%\n" +
+	"\aentries\x18\x01 \x03(\v2\v.ChartEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12;\n" +
+	"\vcomputed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\xc7\x02\n" +
+	"\n" +
+	"DiaryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x03 \x01(\tR\treleaseId\x12\x1f\n" +
+	"\vlistened_on\x18\x04 \x01(\tR\n" +
+	"listenedOn\x12\x19\n" +
+	"\x05score\x18\x05 \x01(\x05H\x00R\x05score\x88\x01\x01\x12\x1b\n" +
+	"\treview_id\x18\x06 \x01(\x04R\breviewId\x12\x1a\n" +
+	"\brelisten\x18\a \x01(\bR\brelisten\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\b\n" +
+	"\x06_score\"\xb7\x01\n" +
+	"\x17CreateDiaryEntryRequest\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x01 \x01(\tR\treleaseId\x12\x1f\n" +
+	"\vlistened_on\x18\x02 \x01(\tR\n" +
+	"listenedOn\x12\x19\n" +
+	"\x05score\x18\x03 \x01(\x05H\x00R\x05score\x88\x01\x01\x12\x1b\n" +
+	"\treview_id\x18\x04 \x01(\x04R\breviewId\x12\x1a\n" +
+	"\brelisten\x18\x05 \x01(\bR\brelistenB\b\n" +
+	"\x06_score\"\xa6\x01\n" +
+	"\x15EditDiaryEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vlistened_on\x18\x02 \x01(\tR\n" +
+	"listenedOn\x12\x19\n" +
+	"\x05score\x18\x03 \x01(\x05H\x00R\x05score\x88\x01\x01\x12\x1b\n" +
+	"\treview_id\x18\x04 \x01(\x04R\breviewId\x12\x1a\n" +
+	"\brelisten\x18\x05 \x01(\bR\brelistenB\b\n" +
+	"\x06_score\")\n" +
+	"\x17DeleteDiaryEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x91\x01\n" +
+	"\x10ListDiaryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\x05R\x05month\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"b\n" +
+	"\x11ListDiaryResponse\x12%\n" +
+	"\aentries\x18\x01 \x03(\v2\v.DiaryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"C\n" +
+	"\x14GetDiaryStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"F\n" +
+	"\rArtistListens\x12\x1b\n" +
+	"\tartist_id\x18\x01 \x01(\tR\bartistId\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x03R\aentries\"\xe1\x01\n" +
+	"\n" +
+	"DiaryStats\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x03R\aentries\x12\x1a\n" +
+	"\breleases\x18\x03 \x01(\x03R\breleases\x12\x1c\n" +
+	"\trelistens\x18\x04 \x01(\x03R\trelistens\x12(\n" +
+	"\raverage_score\x18\x05 \x01(\x02H\x00R\faverageScore\x88\x01\x01\x12/\n" +
+	"\vtop_artists\x18\x06 \x03(\v2\x0e.ArtistListensR\n" +
+	"topArtistsB\x10\n" +
+	"\x0e_average_score\"\x9d\x02\n" +
+	"\x04List\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06public\x18\x05 \x01(\bR\x06public\x12\x14\n" +
+	"\x05items\x18\x06 \x01(\x05R\x05items\x12\x14\n" +
+	"\x05likes\x18\a \x01(\x03R\x05likes\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xbd\x01\n" +
+	"\bListItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x04R\x06listId\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x03 \x01(\tR\treleaseId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x11CreateListRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\"q\n" +
+	"\x11UpdateListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06public\x18\x04 \x01(\bR\x06public\"#\n" +
+	"\x11DeleteListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\" \n" +
+	"\x0eGetListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xa3\x01\n" +
+	"\x12BrowseListsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x02 \x01(\tR\treleaseId\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"Z\n" +
+	"\x13BrowseListsResponse\x12\x1b\n" +
+	"\x05lists\x18\x01 \x03(\v2\x05.ListR\x05lists\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"|\n" +
+	"\x12AddListItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\x04R\x06listId\x12\x1d\n" +
+	"\n" +
+	"release_id\x18\x02 \x01(\tR\treleaseId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"9\n" +
+	"\x13EditListItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"A\n" +
+	"\x13MoveListItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"'\n" +
+	"\x15RemoveListItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"j\n" +
+	"\x13GetListItemsRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\x04R\x06listId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"_\n" +
+	"\x14GetListItemsResponse\x12\x1f\n" +
+	"\x05items\x18\x01 \x03(\v2\t.ListItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"*\n" +
+	"\x0fLikeListRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\x04R\x06listId\"(\n" +
+	"\x10LikeListResponse\x12\x14\n" +
+	"\x05likes\x18\x01 \x01(\x03R\x05likes\",\n" +
+	"\x11UnlikeListRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\x04R\x06listId\"*\n" +
+	"\x12UnlikeListResponse\x12\x14\n" +
+	"\x05likes\x18\x01 \x01(\x03R\x05likes2\x95\x11\n" +
+	"\vMarkService\x125\n" +
+	"\n" +
+	"GetReviews\x12\x12.GetReviewsRequest\x1a\x13.GetReviewsResponse\x12<\n" +
+	"\fDeleteReview\x12\x14.DeleteReviewRequest\x1a\x16.google.protobuf.Empty\x12!\n" +
+	"\aGetMark\x12\x0f.GetMarkRequest\x1a\x05.Mark\x122\n" +
+	"\tListMarks\x12\x11.ListMarksRequest\x1a\x12.ListMarksResponse\x129\n" +
+	"\x13GetReleaseGroupMark\x12\x1b.GetReleaseGroupMarkRequest\x1a\x05.Mark\x12-\n" +
+	"\rGetArtistMark\x12\x15.GetArtistMarkRequest\x1a\x05.Mark\x12/\n" +
+	"\fCreateReview\x12\a.Review\x1a\x16.google.protobuf.Empty\x12-\n" +
+	"\fUpdateReview\x12\x14.UpdateReviewRequest\x1a\a.Review\x12\x1d\n" +
+	"\x04Rate\x12\f.RateRequest\x1a\a.Review\x12P\n" +
+	"\x13ListReviewRevisions\x12\x1b.ListReviewRevisionsRequest\x1a\x1c.ListReviewRevisionsResponse\x125\n" +
+	"\n" +
+	"LikeReview\x12\x12.LikeReviewRequest\x1a\x13.LikeReviewResponse\x12;\n" +
+	"\fUnlikeReview\x12\x14.UnlikeReviewRequest\x1a\x15.UnlikeReviewResponse\x12G\n" +
+	"\x10ListReviewLikers\x12\x18.ListReviewLikersRequest\x1a\x19.ListReviewLikersResponse\x120\n" +
+	"\rCreateComment\x12\x15.CreateCommentRequest\x1a\b.Comment\x12,\n" +
+	"\vEditComment\x12\x13.EditCommentRequest\x1a\b.Comment\x12>\n" +
+	"\rDeleteComment\x12\x15.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\fListComments\x12\x14.ListCommentsRequest\x1a\x15.ListCommentsResponse\x12<\n" +
+	"\fReportReview\x12\x14.ReportReviewRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x13ListModerationQueue\x12\x1b.ListModerationQueueRequest\x1a\x1c.ListModerationQueueResponse\x12-\n" +
+	"\n" +
+	"HideReview\x12\x16.ModerateReviewRequest\x1a\a.Review\x120\n" +
+	"\rRestoreReview\x12\x16.ModerateReviewRequest\x1a\a.Review\x12A\n" +
+	"\x0eResolveReports\x12\x16.ModerateReviewRequest\x1a\x17.ResolveReportsResponse\x12/\n" +
+	"\bGetChart\x12\x10.GetChartRequest\x1a\x11.GetChartResponse\x129\n" +
+	"\x10CreateDiaryEntry\x12\x18.CreateDiaryEntryRequest\x1a\v.DiaryEntry\x125\n" +
+	"\x0eEditDiaryEntry\x12\x16.EditDiaryEntryRequest\x1a\v.DiaryEntry\x12D\n" +
+	"\x10DeleteDiaryEntry\x12\x18.DeleteDiaryEntryRequest\x1a\x16.google.protobuf.Empty\x122\n" +
+	"\tListDiary\x12\x11.ListDiaryRequest\x1a\x12.ListDiaryResponse\x123\n" +
+	"\rGetDiaryStats\x12\x15.GetDiaryStatsRequest\x1a\v.DiaryStats\x12'\n" +
+	"\n" +
+	"CreateList\x12\x12.CreateListRequest\x1a\x05.List\x12'\n" +
+	"\n" +
+	"UpdateList\x12\x12.UpdateListRequest\x1a\x05.List\x128\n" +
+	"\n" +
+	"DeleteList\x12\x12.DeleteListRequest\x1a\x16.google.protobuf.Empty\x12!\n" +
+	"\aGetList\x12\x0f.GetListRequest\x1a\x05.List\x128\n" +
+	"\vBrowseLists\x12\x13.BrowseListsRequest\x1a\x14.BrowseListsResponse\x12-\n" +
+	"\vAddListItem\x12\x13.AddListItemRequest\x1a\t.ListItem\x12/\n" +
+	"\fEditListItem\x12\x14.EditListItemRequest\x1a\t.ListItem\x12/\n" +
+	"\fMoveListItem\x12\x14.MoveListItemRequest\x1a\t.ListItem\x12@\n" +
+	"\x0eRemoveListItem\x12\x16.RemoveListItemRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\fGetListItems\x12\x14.GetListItemsRequest\x1a\x15.GetListItemsResponse\x12/\n" +
+	"\bLikeList\x12\x10.LikeListRequest\x1a\x11.LikeListResponse\x125\n" +
+	"\n" +
+	"UnlikeList\x12\x12.UnlikeListRequest\x1a\x13.UnlikeListResponseB\"Z ./services/mark/api/proto/gen/pbb\x06proto3"
 
 var (
 	file_services_mark_api_proto_mark_proto_rawDescOnce sync.Once
@@ -492,37 +4404,189 @@ func file_services_mark_api_proto_mark_proto_rawDescGZIP() []byte {
 	return file_services_mark_api_proto_mark_proto_rawDescData
 }
 
-var file_services_mark_api_proto_mark_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_services_mark_api_proto_mark_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_services_mark_api_proto_mark_proto_goTypes = []any{
-	(*Mark)(nil),                // 0: Mark
-	(*Review)(nil),              // 1: Review
-	(*IncLikeRequest)(nil),      // 2: IncLikeRequest
-	(*DecLikeRequest)(nil),      // 3: DecLikeRequest
-	(*GetMarkRequest)(nil),      // 4: GetMarkRequest
-	(*GetReviewsResponse)(nil),  // 5: GetReviewsResponse
-	(*GetReviewsRequest)(nil),   // 6: GetReviewsRequest
-	(*DeleteReviewRequest)(nil), // 7: DeleteReviewRequest
-	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
+	(*Mark)(nil),                        // 0: Mark
+	(*Review)(nil),                      // 1: Review
+	(*Comment)(nil),                     // 2: Comment
+	(*ReviewRevision)(nil),              // 3: ReviewRevision
+	(*ReviewLike)(nil),                  // 4: ReviewLike
+	(*LikeReviewRequest)(nil),           // 5: LikeReviewRequest
+	(*LikeReviewResponse)(nil),          // 6: LikeReviewResponse
+	(*UnlikeReviewRequest)(nil),         // 7: UnlikeReviewRequest
+	(*UnlikeReviewResponse)(nil),        // 8: UnlikeReviewResponse
+	(*ListReviewLikersRequest)(nil),     // 9: ListReviewLikersRequest
+	(*ListReviewLikersResponse)(nil),    // 10: ListReviewLikersResponse
+	(*GetMarkRequest)(nil),              // 11: GetMarkRequest
+	(*GetReleaseGroupMarkRequest)(nil),  // 12: GetReleaseGroupMarkRequest
+	(*GetArtistMarkRequest)(nil),        // 13: GetArtistMarkRequest
+	(*ListMarksRequest)(nil),            // 14: ListMarksRequest
+	(*ListMarksResponse)(nil),           // 15: ListMarksResponse
+	(*GetReviewsResponse)(nil),          // 16: GetReviewsResponse
+	(*GetReviewsRequest)(nil),           // 17: GetReviewsRequest
+	(*RateRequest)(nil),                 // 18: RateRequest
+	(*UpdateReviewRequest)(nil),         // 19: UpdateReviewRequest
+	(*ListReviewRevisionsRequest)(nil),  // 20: ListReviewRevisionsRequest
+	(*ListReviewRevisionsResponse)(nil), // 21: ListReviewRevisionsResponse
+	(*DeleteReviewRequest)(nil),         // 22: DeleteReviewRequest
+	(*CreateCommentRequest)(nil),        // 23: CreateCommentRequest
+	(*EditCommentRequest)(nil),          // 24: EditCommentRequest
+	(*DeleteCommentRequest)(nil),        // 25: DeleteCommentRequest
+	(*ListCommentsRequest)(nil),         // 26: ListCommentsRequest
+	(*ListCommentsResponse)(nil),        // 27: ListCommentsResponse
+	(*ReportReviewRequest)(nil),         // 28: ReportReviewRequest
+	(*ModerationItem)(nil),              // 29: ModerationItem
+	(*ListModerationQueueRequest)(nil),  // 30: ListModerationQueueRequest
+	(*ListModerationQueueResponse)(nil), // 31: ListModerationQueueResponse
+	(*ModerateReviewRequest)(nil),       // 32: ModerateReviewRequest
+	(*ResolveReportsResponse)(nil),      // 33: ResolveReportsResponse
+	(*ChartEntry)(nil),                  // 34: ChartEntry
+	(*GetChartRequest)(nil),             // 35: GetChartRequest
+	(*GetChartResponse)(nil),            // 36: GetChartResponse
+	(*DiaryEntry)(nil),                  // 37: DiaryEntry
+	(*CreateDiaryEntryRequest)(nil),     // 38: CreateDiaryEntryRequest
+	(*EditDiaryEntryRequest)(nil),       // 39: EditDiaryEntryRequest
+	(*DeleteDiaryEntryRequest)(nil),     // 40: DeleteDiaryEntryRequest
+	(*ListDiaryRequest)(nil),            // 41: ListDiaryRequest
+	(*ListDiaryResponse)(nil),           // 42: ListDiaryResponse
+	(*GetDiaryStatsRequest)(nil),        // 43: GetDiaryStatsRequest
+	(*ArtistListens)(nil),               // 44: ArtistListens
+	(*DiaryStats)(nil),                  // 45: DiaryStats
+	(*List)(nil),                        // 46: List
+	(*ListItem)(nil),                    // 47: ListItem
+	(*CreateListRequest)(nil),           // 48: CreateListRequest
+	(*UpdateListRequest)(nil),           // 49: UpdateListRequest
+	(*DeleteListRequest)(nil),           // 50: DeleteListRequest
+	(*GetListRequest)(nil),              // 51: GetListRequest
+	(*BrowseListsRequest)(nil),          // 52: BrowseListsRequest
+	(*BrowseListsResponse)(nil),         // 53: BrowseListsResponse
+	(*AddListItemRequest)(nil),          // 54: AddListItemRequest
+	(*EditListItemRequest)(nil),         // 55: EditListItemRequest
+	(*MoveListItemRequest)(nil),         // 56: MoveListItemRequest
+	(*RemoveListItemRequest)(nil),       // 57: RemoveListItemRequest
+	(*GetListItemsRequest)(nil),         // 58: GetListItemsRequest
+	(*GetListItemsResponse)(nil),        // 59: GetListItemsResponse
+	(*LikeListRequest)(nil),             // 60: LikeListRequest
+	(*LikeListResponse)(nil),            // 61: LikeListResponse
+	(*UnlikeListRequest)(nil),           // 62: UnlikeListRequest
+	(*UnlikeListResponse)(nil),          // 63: UnlikeListResponse
+	nil,                                 // 64: ModerationItem.ReasonsEntry
+	(*timestamppb.Timestamp)(nil),       // 65: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 66: google.protobuf.Empty
 }
 var file_services_mark_api_proto_mark_proto_depIdxs = []int32{
-	1, // 0: GetReviewsResponse.reviews:type_name -> Review
-	6, // 1: MarkService.GetReviews:input_type -> GetReviewsRequest
-	7, // 2: MarkService.DeleteReview:input_type -> DeleteReviewRequest
-	4, // 3: MarkService.GetMark:input_type -> GetMarkRequest
-	1, // 4: MarkService.CreateReview:input_type -> Review
-	2, // 5: MarkService.IncLike:input_type -> IncLikeRequest
-	3, // 6: MarkService.DecLike:input_type -> DecLikeRequest
-	5, // 7: MarkService.GetReviews:output_type -> GetReviewsResponse
-	8, // 8: MarkService.DeleteReview:output_type -> google.protobuf.Empty
-	0, // 9: MarkService.GetMark:output_type -> Mark
-	8, // 10: MarkService.CreateReview:output_type -> google.protobuf.Empty
-	8, // 11: MarkService.IncLike:output_type -> google.protobuf.Empty
-	8, // 12: MarkService.DecLike:output_type -> google.protobuf.Empty
-	7, // [7:13] is the sub-list for method output_type
-	1, // [1:7] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	65, // 0: Review.edited_at:type_name -> google.protobuf.Timestamp
+	65, // 1: Review.hidden_at:type_name -> google.protobuf.Timestamp
+	65, // 2: Comment.created_at:type_name -> google.protobuf.Timestamp
+	65, // 3: Comment.edited_at:type_name -> google.protobuf.Timestamp
+	65, // 4: ReviewRevision.written_at:type_name -> google.protobuf.Timestamp
+	65, // 5: ReviewRevision.replaced_at:type_name -> google.protobuf.Timestamp
+	65, // 6: ReviewLike.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 7: ListReviewLikersResponse.likers:type_name -> ReviewLike
+	0,  // 8: ListMarksResponse.marks:type_name -> Mark
+	1,  // 9: GetReviewsResponse.reviews:type_name -> Review
+	3,  // 10: ListReviewRevisionsResponse.revisions:type_name -> ReviewRevision
+	2,  // 11: ListCommentsResponse.comments:type_name -> Comment
+	1,  // 12: ModerationItem.review:type_name -> Review
+	64, // 13: ModerationItem.reasons:type_name -> ModerationItem.ReasonsEntry
+	65, // 14: ModerationItem.first_reported_at:type_name -> google.protobuf.Timestamp
+	29, // 15: ListModerationQueueResponse.items:type_name -> ModerationItem
+	34, // 16: GetChartResponse.entries:type_name -> ChartEntry
+	65, // 17: GetChartResponse.computed_at:type_name -> google.protobuf.Timestamp
+	65, // 18: DiaryEntry.created_at:type_name -> google.protobuf.Timestamp
+	65, // 19: DiaryEntry.edited_at:type_name -> google.protobuf.Timestamp
+	37, // 20: ListDiaryResponse.entries:type_name -> DiaryEntry
+	44, // 21: DiaryStats.top_artists:type_name -> ArtistListens
+	65, // 22: List.created_at:type_name -> google.protobuf.Timestamp
+	65, // 23: List.edited_at:type_name -> google.protobuf.Timestamp
+	65, // 24: ListItem.created_at:type_name -> google.protobuf.Timestamp
+	46, // 25: BrowseListsResponse.lists:type_name -> List
+	47, // 26: GetListItemsResponse.items:type_name -> ListItem
+	17, // 27: MarkService.GetReviews:input_type -> GetReviewsRequest
+	22, // 28: MarkService.DeleteReview:input_type -> DeleteReviewRequest
+	11, // 29: MarkService.GetMark:input_type -> GetMarkRequest
+	14, // 30: MarkService.ListMarks:input_type -> ListMarksRequest
+	12, // 31: MarkService.GetReleaseGroupMark:input_type -> GetReleaseGroupMarkRequest
+	13, // 32: MarkService.GetArtistMark:input_type -> GetArtistMarkRequest
+	1,  // 33: MarkService.CreateReview:input_type -> Review
+	19, // 34: MarkService.UpdateReview:input_type -> UpdateReviewRequest
+	18, // 35: MarkService.Rate:input_type -> RateRequest
+	20, // 36: MarkService.ListReviewRevisions:input_type -> ListReviewRevisionsRequest
+	5,  // 37: MarkService.LikeReview:input_type -> LikeReviewRequest
+	7,  // 38: MarkService.UnlikeReview:input_type -> UnlikeReviewRequest
+	9,  // 39: MarkService.ListReviewLikers:input_type -> ListReviewLikersRequest
+	23, // 40: MarkService.CreateComment:input_type -> CreateCommentRequest
+	24, // 41: MarkService.EditComment:input_type -> EditCommentRequest
+	25, // 42: MarkService.DeleteComment:input_type -> DeleteCommentRequest
+	26, // 43: MarkService.ListComments:input_type -> ListCommentsRequest
+	28, // 44: MarkService.ReportReview:input_type -> ReportReviewRequest
+	30, // 45: MarkService.ListModerationQueue:input_type -> ListModerationQueueRequest
+	32, // 46: MarkService.HideReview:input_type -> ModerateReviewRequest
+	32, // 47: MarkService.RestoreReview:input_type -> ModerateReviewRequest
+	32, // 48: MarkService.ResolveReports:input_type -> ModerateReviewRequest
+	35, // 49: MarkService.GetChart:input_type -> GetChartRequest
+	38, // 50: MarkService.CreateDiaryEntry:input_type -> CreateDiaryEntryRequest
+	39, // 51: MarkService.EditDiaryEntry:input_type -> EditDiaryEntryRequest
+	40, // 52: MarkService.DeleteDiaryEntry:input_type -> DeleteDiaryEntryRequest
+	41, // 53: MarkService.ListDiary:input_type -> ListDiaryRequest
+	43, // 54: MarkService.GetDiaryStats:input_type -> GetDiaryStatsRequest
+	48, // 55: MarkService.CreateList:input_type -> CreateListRequest
+	49, // 56: MarkService.UpdateList:input_type -> UpdateListRequest
+	50, // 57: MarkService.DeleteList:input_type -> DeleteListRequest
+	51, // 58: MarkService.GetList:input_type -> GetListRequest
+	52, // 59: MarkService.BrowseLists:input_type -> BrowseListsRequest
+	54, // 60: MarkService.AddListItem:input_type -> AddListItemRequest
+	55, // 61: MarkService.EditListItem:input_type -> EditListItemRequest
+	56, // 62: MarkService.MoveListItem:input_type -> MoveListItemRequest
+	57, // 63: MarkService.RemoveListItem:input_type -> RemoveListItemRequest
+	58, // 64: MarkService.GetListItems:input_type -> GetListItemsRequest
+	60, // 65: MarkService.LikeList:input_type -> LikeListRequest
+	62, // 66: MarkService.UnlikeList:input_type -> UnlikeListRequest
+	16, // 67: MarkService.GetReviews:output_type -> GetReviewsResponse
+	66, // 68: MarkService.DeleteReview:output_type -> google.protobuf.Empty
+	0,  // 69: MarkService.GetMark:output_type -> Mark
+	15, // 70: MarkService.ListMarks:output_type -> ListMarksResponse
+	0,  // 71: MarkService.GetReleaseGroupMark:output_type -> Mark
+	0,  // 72: MarkService.GetArtistMark:output_type -> Mark
+	66, // 73: MarkService.CreateReview:output_type -> google.protobuf.Empty
+	1,  // 74: MarkService.UpdateReview:output_type -> Review
+	1,  // 75: MarkService.Rate:output_type -> Review
+	21, // 76: MarkService.ListReviewRevisions:output_type -> ListReviewRevisionsResponse
+	6,  // 77: MarkService.LikeReview:output_type -> LikeReviewResponse
+	8,  // 78: MarkService.UnlikeReview:output_type -> UnlikeReviewResponse
+	10, // 79: MarkService.ListReviewLikers:output_type -> ListReviewLikersResponse
+	2,  // 80: MarkService.CreateComment:output_type -> Comment
+	2,  // 81: MarkService.EditComment:output_type -> Comment
+	66, // 82: MarkService.DeleteComment:output_type -> google.protobuf.Empty
+	27, // 83: MarkService.ListComments:output_type -> ListCommentsResponse
+	66, // 84: MarkService.ReportReview:output_type -> google.protobuf.Empty
+	31, // 85: MarkService.ListModerationQueue:output_type -> ListModerationQueueResponse
+	1,  // 86: MarkService.HideReview:output_type -> Review
+	1,  // 87: MarkService.RestoreReview:output_type -> Review
+	33, // 88: MarkService.ResolveReports:output_type -> ResolveReportsResponse
+	36, // 89: MarkService.GetChart:output_type -> GetChartResponse
+	37, // 90: MarkService.CreateDiaryEntry:output_type -> DiaryEntry
+	37, // 91: MarkService.EditDiaryEntry:output_type -> DiaryEntry
+	66, // 92: MarkService.DeleteDiaryEntry:output_type -> google.protobuf.Empty
+	42, // 93: MarkService.ListDiary:output_type -> ListDiaryResponse
+	45, // 94: MarkService.GetDiaryStats:output_type -> DiaryStats
+	46, // 95: MarkService.CreateList:output_type -> List
+	46, // 96: MarkService.UpdateList:output_type -> List
+	66, // 97: MarkService.DeleteList:output_type -> google.protobuf.Empty
+	46, // 98: MarkService.GetList:output_type -> List
+	53, // 99: MarkService.BrowseLists:output_type -> BrowseListsResponse
+	47, // 100: MarkService.AddListItem:output_type -> ListItem
+	47, // 101: MarkService.EditListItem:output_type -> ListItem
+	47, // 102: MarkService.MoveListItem:output_type -> ListItem
+	66, // 103: MarkService.RemoveListItem:output_type -> google.protobuf.Empty
+	59, // 104: MarkService.GetListItems:output_type -> GetListItemsResponse
+	61, // 105: MarkService.LikeList:output_type -> LikeListResponse
+	63, // 106: MarkService.UnlikeList:output_type -> UnlikeListResponse
+	67, // [67:107] is the sub-list for method output_type
+	27, // [27:67] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_services_mark_api_proto_mark_proto_init() }
@@ -530,13 +4594,19 @@ func file_services_mark_api_proto_mark_proto_init() {
 	if File_services_mark_api_proto_mark_proto != nil {
 		return
 	}
+	file_services_mark_api_proto_mark_proto_msgTypes[17].OneofWrappers = []any{}
+	file_services_mark_api_proto_mark_proto_msgTypes[26].OneofWrappers = []any{}
+	file_services_mark_api_proto_mark_proto_msgTypes[37].OneofWrappers = []any{}
+	file_services_mark_api_proto_mark_proto_msgTypes[38].OneofWrappers = []any{}
+	file_services_mark_api_proto_mark_proto_msgTypes[39].OneofWrappers = []any{}
+	file_services_mark_api_proto_mark_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_mark_api_proto_mark_proto_rawDesc), len(file_services_mark_api_proto_mark_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,12 +20,46 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MarkService_GetReviews_FullMethodName   = "/MarkService/GetReviews"
-	MarkService_DeleteReview_FullMethodName = "/MarkService/DeleteReview"
-	MarkService_GetMark_FullMethodName      = "/MarkService/GetMark"
-	MarkService_CreateReview_FullMethodName = "/MarkService/CreateReview"
-	MarkService_IncLike_FullMethodName      = "/MarkService/IncLike"
-	MarkService_DecLike_FullMethodName      = "/MarkService/DecLike"
+	MarkService_GetReviews_FullMethodName          = "/MarkService/GetReviews"
+	MarkService_DeleteReview_FullMethodName        = "/MarkService/DeleteReview"
+	MarkService_GetMark_FullMethodName             = "/MarkService/GetMark"
+	MarkService_ListMarks_FullMethodName           = "/MarkService/ListMarks"
+	MarkService_GetReleaseGroupMark_FullMethodName = "/MarkService/GetReleaseGroupMark"
+	MarkService_GetArtistMark_FullMethodName       = "/MarkService/GetArtistMark"
+	MarkService_CreateReview_FullMethodName        = "/MarkService/CreateReview"
+	MarkService_UpdateReview_FullMethodName        = "/MarkService/UpdateReview"
+	MarkService_Rate_FullMethodName                = "/MarkService/Rate"
+	MarkService_ListReviewRevisions_FullMethodName = "/MarkService/ListReviewRevisions"
+	MarkService_LikeReview_FullMethodName          = "/MarkService/LikeReview"
+	MarkService_UnlikeReview_FullMethodName        = "/MarkService/UnlikeReview"
+	MarkService_ListReviewLikers_FullMethodName    = "/MarkService/ListReviewLikers"
+	MarkService_CreateComment_FullMethodName       = "/MarkService/CreateComment"
+	MarkService_EditComment_FullMethodName         = "/MarkService/EditComment"
+	MarkService_DeleteComment_FullMethodName       = "/MarkService/DeleteComment"
+	MarkService_ListComments_FullMethodName        = "/MarkService/ListComments"
+	MarkService_ReportReview_FullMethodName        = "/MarkService/ReportReview"
+	MarkService_ListModerationQueue_FullMethodName = "/MarkService/ListModerationQueue"
+	MarkService_HideReview_FullMethodName          = "/MarkService/HideReview"
+	MarkService_RestoreReview_FullMethodName       = "/MarkService/RestoreReview"
+	MarkService_ResolveReports_FullMethodName      = "/MarkService/ResolveReports"
+	MarkService_GetChart_FullMethodName            = "/MarkService/GetChart"
+	MarkService_CreateDiaryEntry_FullMethodName    = "/MarkService/CreateDiaryEntry"
+	MarkService_EditDiaryEntry_FullMethodName      = "/MarkService/EditDiaryEntry"
+	MarkService_DeleteDiaryEntry_FullMethodName    = "/MarkService/DeleteDiaryEntry"
+	MarkService_ListDiary_FullMethodName           = "/MarkService/ListDiary"
+	MarkService_GetDiaryStats_FullMethodName       = "/MarkService/GetDiaryStats"
+	MarkService_CreateList_FullMethodName          = "/MarkService/CreateList"
+	MarkService_UpdateList_FullMethodName          = "/MarkService/UpdateList"
+	MarkService_DeleteList_FullMethodName          = "/MarkService/DeleteList"
+	MarkService_GetList_FullMethodName             = "/MarkService/GetList"
+	MarkService_BrowseLists_FullMethodName         = "/MarkService/BrowseLists"
+	MarkService_AddListItem_FullMethodName         = "/MarkService/AddListItem"
+	MarkService_EditListItem_FullMethodName        = "/MarkService/EditListItem"
+	MarkService_MoveListItem_FullMethodName        = "/MarkService/MoveListItem"
+	MarkService_RemoveListItem_FullMethodName      = "/MarkService/RemoveListItem"
+	MarkService_GetListItems_FullMethodName        = "/MarkService/GetListItems"
+	MarkService_LikeList_FullMethodName            = "/MarkService/LikeList"
+	MarkService_UnlikeList_FullMethodName          = "/MarkService/UnlikeList"
 )
 
 // MarkServiceClient is the client API for MarkService service.
@@ -35,9 +69,67 @@ type MarkServiceClient interface {
 	GetReviews(ctx context.Context, in *GetReviewsRequest, opts ...grpc.CallOption) (*GetReviewsResponse, error)
 	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetMark(ctx context.Context, in *GetMarkRequest, opts ...grpc.CallOption) (*Mark, error)
+	ListMarks(ctx context.Context, in *ListMarksRequest, opts ...grpc.CallOption) (*ListMarksResponse, error)
+	// Marks of release groups and artists sum up the reviews of all their
+	// releases. They have no id, release or weighted value of their own.
+	GetReleaseGroupMark(ctx context.Context, in *GetReleaseGroupMarkRequest, opts ...grpc.CallOption) (*Mark, error)
+	GetArtistMark(ctx context.Context, in *GetArtistMarkRequest, opts ...grpc.CallOption) (*Mark, error)
 	CreateReview(ctx context.Context, in *Review, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	IncLike(ctx context.Context, in *IncLikeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	DecLike(ctx context.Context, in *DecLikeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Rate sets the caller's score for a release, creating a review without
+	// text unless the caller already reviewed it.
+	Rate(ctx context.Context, in *RateRequest, opts ...grpc.CallOption) (*Review, error)
+	ListReviewRevisions(ctx context.Context, in *ListReviewRevisionsRequest, opts ...grpc.CallOption) (*ListReviewRevisionsResponse, error)
+	LikeReview(ctx context.Context, in *LikeReviewRequest, opts ...grpc.CallOption) (*LikeReviewResponse, error)
+	UnlikeReview(ctx context.Context, in *UnlikeReviewRequest, opts ...grpc.CallOption) (*UnlikeReviewResponse, error)
+	ListReviewLikers(ctx context.Context, in *ListReviewLikersRequest, opts ...grpc.CallOption) (*ListReviewLikersResponse, error)
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	// ReportReview flags a review for moderators. Reviews reported by
+	// enough users are hidden until a moderator looks at them.
+	ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListModerationQueue pages through the reviews with open reports,
+	// longest waiting first. It needs the reviews:moderate permission, as
+	// do the methods below.
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	// HideReview takes the review out of marks and listings and closes its
+	// reports; RestoreReview brings it back and closes them as well.
+	HideReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	RestoreReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// ResolveReports closes the open reports and leaves the review as it is.
+	ResolveReports(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error)
+	// GetChart pages through a precomputed ranking of releases by weighted
+	// mark. Charts are rebuilt periodically.
+	GetChart(ctx context.Context, in *GetChartRequest, opts ...grpc.CallOption) (*GetChartResponse, error)
+	// Diary entries log the days users listened to releases. Their scores
+	// rate a single listen and do not count towards marks.
+	CreateDiaryEntry(ctx context.Context, in *CreateDiaryEntryRequest, opts ...grpc.CallOption) (*DiaryEntry, error)
+	EditDiaryEntry(ctx context.Context, in *EditDiaryEntryRequest, opts ...grpc.CallOption) (*DiaryEntry, error)
+	DeleteDiaryEntry(ctx context.Context, in *DeleteDiaryEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListDiary pages through the diary of a user for a year or one month
+	// of it, latest listens first.
+	ListDiary(ctx context.Context, in *ListDiaryRequest, opts ...grpc.CallOption) (*ListDiaryResponse, error)
+	GetDiaryStats(ctx context.Context, in *GetDiaryStatsRequest, opts ...grpc.CallOption) (*DiaryStats, error)
+	// Lists are ordered selections of releases curated by users. Private
+	// lists and their items are found by their author only.
+	CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*List, error)
+	UpdateList(ctx context.Context, in *UpdateListRequest, opts ...grpc.CallOption) (*List, error)
+	DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*List, error)
+	// BrowseLists pages through public lists, newest or most liked first.
+	BrowseLists(ctx context.Context, in *BrowseListsRequest, opts ...grpc.CallOption) (*BrowseListsResponse, error)
+	// AddListItem checks the release with the music service.
+	AddListItem(ctx context.Context, in *AddListItemRequest, opts ...grpc.CallOption) (*ListItem, error)
+	EditListItem(ctx context.Context, in *EditListItemRequest, opts ...grpc.CallOption) (*ListItem, error)
+	// MoveListItem puts the item at a position and shifts the items in
+	// between.
+	MoveListItem(ctx context.Context, in *MoveListItemRequest, opts ...grpc.CallOption) (*ListItem, error)
+	RemoveListItem(ctx context.Context, in *RemoveListItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetListItems(ctx context.Context, in *GetListItemsRequest, opts ...grpc.CallOption) (*GetListItemsResponse, error)
+	LikeList(ctx context.Context, in *LikeListRequest, opts ...grpc.CallOption) (*LikeListResponse, error)
+	UnlikeList(ctx context.Context, in *UnlikeListRequest, opts ...grpc.CallOption) (*UnlikeListResponse, error)
 }
 
 type markServiceClient struct {
@@ -78,6 +170,36 @@ func (c *markServiceClient) GetMark(ctx context.Context, in *GetMarkRequest, opt
 	return out, nil
 }
 
+func (c *markServiceClient) ListMarks(ctx context.Context, in *ListMarksRequest, opts ...grpc.CallOption) (*ListMarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMarksResponse)
+	err := c.cc.Invoke(ctx, MarkService_ListMarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) GetReleaseGroupMark(ctx context.Context, in *GetReleaseGroupMarkRequest, opts ...grpc.CallOption) (*Mark, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mark)
+	err := c.cc.Invoke(ctx, MarkService_GetReleaseGroupMark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) GetArtistMark(ctx context.Context, in *GetArtistMarkRequest, opts ...grpc.CallOption) (*Mark, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mark)
+	err := c.cc.Invoke(ctx, MarkService_GetArtistMark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *markServiceClient) CreateReview(ctx context.Context, in *Review, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -85,192 +207,1274 @@ func (c *markServiceClient) CreateReview(ctx context.Context, in *Review, opts .
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *markServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, MarkService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) Rate(ctx context.Context, in *RateRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, MarkService_Rate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ListReviewRevisions(ctx context.Context, in *ListReviewRevisionsRequest, opts ...grpc.CallOption) (*ListReviewRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewRevisionsResponse)
+	err := c.cc.Invoke(ctx, MarkService_ListReviewRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) LikeReview(ctx context.Context, in *LikeReviewRequest, opts ...grpc.CallOption) (*LikeReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LikeReviewResponse)
+	err := c.cc.Invoke(ctx, MarkService_LikeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) UnlikeReview(ctx context.Context, in *UnlikeReviewRequest, opts ...grpc.CallOption) (*UnlikeReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlikeReviewResponse)
+	err := c.cc.Invoke(ctx, MarkService_UnlikeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ListReviewLikers(ctx context.Context, in *ListReviewLikersRequest, opts ...grpc.CallOption) (*ListReviewLikersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewLikersResponse)
+	err := c.cc.Invoke(ctx, MarkService_ListReviewLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, MarkService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, MarkService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MarkService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, MarkService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MarkService_ReportReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, MarkService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) HideReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, MarkService_HideReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) RestoreReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, MarkService_RestoreReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ResolveReports(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportsResponse)
+	err := c.cc.Invoke(ctx, MarkService_ResolveReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) GetChart(ctx context.Context, in *GetChartRequest, opts ...grpc.CallOption) (*GetChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChartResponse)
+	err := c.cc.Invoke(ctx, MarkService_GetChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) CreateDiaryEntry(ctx context.Context, in *CreateDiaryEntryRequest, opts ...grpc.CallOption) (*DiaryEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiaryEntry)
+	err := c.cc.Invoke(ctx, MarkService_CreateDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) EditDiaryEntry(ctx context.Context, in *EditDiaryEntryRequest, opts ...grpc.CallOption) (*DiaryEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiaryEntry)
+	err := c.cc.Invoke(ctx, MarkService_EditDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) DeleteDiaryEntry(ctx context.Context, in *DeleteDiaryEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MarkService_DeleteDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) ListDiary(ctx context.Context, in *ListDiaryRequest, opts ...grpc.CallOption) (*ListDiaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiaryResponse)
+	err := c.cc.Invoke(ctx, MarkService_ListDiary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) GetDiaryStats(ctx context.Context, in *GetDiaryStatsRequest, opts ...grpc.CallOption) (*DiaryStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiaryStats)
+	err := c.cc.Invoke(ctx, MarkService_GetDiaryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*List, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(List)
+	err := c.cc.Invoke(ctx, MarkService_CreateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) UpdateList(ctx context.Context, in *UpdateListRequest, opts ...grpc.CallOption) (*List, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(List)
+	err := c.cc.Invoke(ctx, MarkService_UpdateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MarkService_DeleteList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*List, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(List)
+	err := c.cc.Invoke(ctx, MarkService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) BrowseLists(ctx context.Context, in *BrowseListsRequest, opts ...grpc.CallOption) (*BrowseListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrowseListsResponse)
+	err := c.cc.Invoke(ctx, MarkService_BrowseLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) AddListItem(ctx context.Context, in *AddListItemRequest, opts ...grpc.CallOption) (*ListItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItem)
+	err := c.cc.Invoke(ctx, MarkService_AddListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) EditListItem(ctx context.Context, in *EditListItemRequest, opts ...grpc.CallOption) (*ListItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItem)
+	err := c.cc.Invoke(ctx, MarkService_EditListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) MoveListItem(ctx context.Context, in *MoveListItemRequest, opts ...grpc.CallOption) (*ListItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItem)
+	err := c.cc.Invoke(ctx, MarkService_MoveListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) RemoveListItem(ctx context.Context, in *RemoveListItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MarkService_RemoveListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) GetListItems(ctx context.Context, in *GetListItemsRequest, opts ...grpc.CallOption) (*GetListItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListItemsResponse)
+	err := c.cc.Invoke(ctx, MarkService_GetListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) LikeList(ctx context.Context, in *LikeListRequest, opts ...grpc.CallOption) (*LikeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LikeListResponse)
+	err := c.cc.Invoke(ctx, MarkService_LikeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *markServiceClient) UnlikeList(ctx context.Context, in *UnlikeListRequest, opts ...grpc.CallOption) (*UnlikeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlikeListResponse)
+	err := c.cc.Invoke(ctx, MarkService_UnlikeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarkServiceServer is the server API for MarkService service.
+// All implementations must embed UnimplementedMarkServiceServer
+// for forward compatibility.
+type MarkServiceServer interface {
+	GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*emptypb.Empty, error)
+	GetMark(context.Context, *GetMarkRequest) (*Mark, error)
+	ListMarks(context.Context, *ListMarksRequest) (*ListMarksResponse, error)
+	// Marks of release groups and artists sum up the reviews of all their
+	// releases. They have no id, release or weighted value of their own.
+	GetReleaseGroupMark(context.Context, *GetReleaseGroupMarkRequest) (*Mark, error)
+	GetArtistMark(context.Context, *GetArtistMarkRequest) (*Mark, error)
+	CreateReview(context.Context, *Review) (*emptypb.Empty, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error)
+	// Rate sets the caller's score for a release, creating a review without
+	// text unless the caller already reviewed it.
+	Rate(context.Context, *RateRequest) (*Review, error)
+	ListReviewRevisions(context.Context, *ListReviewRevisionsRequest) (*ListReviewRevisionsResponse, error)
+	LikeReview(context.Context, *LikeReviewRequest) (*LikeReviewResponse, error)
+	UnlikeReview(context.Context, *UnlikeReviewRequest) (*UnlikeReviewResponse, error)
+	ListReviewLikers(context.Context, *ListReviewLikersRequest) (*ListReviewLikersResponse, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
+	EditComment(context.Context, *EditCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	// ReportReview flags a review for moderators. Reviews reported by
+	// enough users are hidden until a moderator looks at them.
+	ReportReview(context.Context, *ReportReviewRequest) (*emptypb.Empty, error)
+	// ListModerationQueue pages through the reviews with open reports,
+	// longest waiting first. It needs the reviews:moderate permission, as
+	// do the methods below.
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	// HideReview takes the review out of marks and listings and closes its
+	// reports; RestoreReview brings it back and closes them as well.
+	HideReview(context.Context, *ModerateReviewRequest) (*Review, error)
+	RestoreReview(context.Context, *ModerateReviewRequest) (*Review, error)
+	// ResolveReports closes the open reports and leaves the review as it is.
+	ResolveReports(context.Context, *ModerateReviewRequest) (*ResolveReportsResponse, error)
+	// GetChart pages through a precomputed ranking of releases by weighted
+	// mark. Charts are rebuilt periodically.
+	GetChart(context.Context, *GetChartRequest) (*GetChartResponse, error)
+	// Diary entries log the days users listened to releases. Their scores
+	// rate a single listen and do not count towards marks.
+	CreateDiaryEntry(context.Context, *CreateDiaryEntryRequest) (*DiaryEntry, error)
+	EditDiaryEntry(context.Context, *EditDiaryEntryRequest) (*DiaryEntry, error)
+	DeleteDiaryEntry(context.Context, *DeleteDiaryEntryRequest) (*emptypb.Empty, error)
+	// ListDiary pages through the diary of a user for a year or one month
+	// of it, latest listens first.
+	ListDiary(context.Context, *ListDiaryRequest) (*ListDiaryResponse, error)
+	GetDiaryStats(context.Context, *GetDiaryStatsRequest) (*DiaryStats, error)
+	// Lists are ordered selections of releases curated by users. Private
+	// lists and their items are found by their author only.
+	CreateList(context.Context, *CreateListRequest) (*List, error)
+	UpdateList(context.Context, *UpdateListRequest) (*List, error)
+	DeleteList(context.Context, *DeleteListRequest) (*emptypb.Empty, error)
+	GetList(context.Context, *GetListRequest) (*List, error)
+	// BrowseLists pages through public lists, newest or most liked first.
+	BrowseLists(context.Context, *BrowseListsRequest) (*BrowseListsResponse, error)
+	// AddListItem checks the release with the music service.
+	AddListItem(context.Context, *AddListItemRequest) (*ListItem, error)
+	EditListItem(context.Context, *EditListItemRequest) (*ListItem, error)
+	// MoveListItem puts the item at a position and shifts the items in
+	// between.
+	MoveListItem(context.Context, *MoveListItemRequest) (*ListItem, error)
+	RemoveListItem(context.Context, *RemoveListItemRequest) (*emptypb.Empty, error)
+	GetListItems(context.Context, *GetListItemsRequest) (*GetListItemsResponse, error)
+	LikeList(context.Context, *LikeListRequest) (*LikeListResponse, error)
+	UnlikeList(context.Context, *UnlikeListRequest) (*UnlikeListResponse, error)
+	mustEmbedUnimplementedMarkServiceServer()
+}
+
+// UnimplementedMarkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMarkServiceServer struct{}
+
+func (UnimplementedMarkServiceServer) GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviews not implemented")
+}
+func (UnimplementedMarkServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedMarkServiceServer) GetMark(context.Context, *GetMarkRequest) (*Mark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMark not implemented")
+}
+func (UnimplementedMarkServiceServer) ListMarks(context.Context, *ListMarksRequest) (*ListMarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarks not implemented")
+}
+func (UnimplementedMarkServiceServer) GetReleaseGroupMark(context.Context, *GetReleaseGroupMarkRequest) (*Mark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseGroupMark not implemented")
+}
+func (UnimplementedMarkServiceServer) GetArtistMark(context.Context, *GetArtistMarkRequest) (*Mark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtistMark not implemented")
+}
+func (UnimplementedMarkServiceServer) CreateReview(context.Context, *Review) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedMarkServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedMarkServiceServer) Rate(context.Context, *RateRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
+}
+func (UnimplementedMarkServiceServer) ListReviewRevisions(context.Context, *ListReviewRevisionsRequest) (*ListReviewRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewRevisions not implemented")
+}
+func (UnimplementedMarkServiceServer) LikeReview(context.Context, *LikeReviewRequest) (*LikeReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeReview not implemented")
+}
+func (UnimplementedMarkServiceServer) UnlikeReview(context.Context, *UnlikeReviewRequest) (*UnlikeReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeReview not implemented")
+}
+func (UnimplementedMarkServiceServer) ListReviewLikers(context.Context, *ListReviewLikersRequest) (*ListReviewLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewLikers not implemented")
+}
+func (UnimplementedMarkServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedMarkServiceServer) EditComment(context.Context, *EditCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedMarkServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedMarkServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedMarkServiceServer) ReportReview(context.Context, *ReportReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReview not implemented")
+}
+func (UnimplementedMarkServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedMarkServiceServer) HideReview(context.Context, *ModerateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideReview not implemented")
+}
+func (UnimplementedMarkServiceServer) RestoreReview(context.Context, *ModerateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReview not implemented")
+}
+func (UnimplementedMarkServiceServer) ResolveReports(context.Context, *ModerateReviewRequest) (*ResolveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
+func (UnimplementedMarkServiceServer) GetChart(context.Context, *GetChartRequest) (*GetChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChart not implemented")
+}
+func (UnimplementedMarkServiceServer) CreateDiaryEntry(context.Context, *CreateDiaryEntryRequest) (*DiaryEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiaryEntry not implemented")
+}
+func (UnimplementedMarkServiceServer) EditDiaryEntry(context.Context, *EditDiaryEntryRequest) (*DiaryEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditDiaryEntry not implemented")
+}
+func (UnimplementedMarkServiceServer) DeleteDiaryEntry(context.Context, *DeleteDiaryEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiaryEntry not implemented")
+}
+func (UnimplementedMarkServiceServer) ListDiary(context.Context, *ListDiaryRequest) (*ListDiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiary not implemented")
+}
+func (UnimplementedMarkServiceServer) GetDiaryStats(context.Context, *GetDiaryStatsRequest) (*DiaryStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiaryStats not implemented")
+}
+func (UnimplementedMarkServiceServer) CreateList(context.Context, *CreateListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateList not implemented")
+}
+func (UnimplementedMarkServiceServer) UpdateList(context.Context, *UpdateListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateList not implemented")
+}
+func (UnimplementedMarkServiceServer) DeleteList(context.Context, *DeleteListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteList not implemented")
+}
+func (UnimplementedMarkServiceServer) GetList(context.Context, *GetListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedMarkServiceServer) BrowseLists(context.Context, *BrowseListsRequest) (*BrowseListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrowseLists not implemented")
+}
+func (UnimplementedMarkServiceServer) AddListItem(context.Context, *AddListItemRequest) (*ListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListItem not implemented")
+}
+func (UnimplementedMarkServiceServer) EditListItem(context.Context, *EditListItemRequest) (*ListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditListItem not implemented")
+}
+func (UnimplementedMarkServiceServer) MoveListItem(context.Context, *MoveListItemRequest) (*ListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveListItem not implemented")
+}
+func (UnimplementedMarkServiceServer) RemoveListItem(context.Context, *RemoveListItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListItem not implemented")
+}
+func (UnimplementedMarkServiceServer) GetListItems(context.Context, *GetListItemsRequest) (*GetListItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListItems not implemented")
+}
+func (UnimplementedMarkServiceServer) LikeList(context.Context, *LikeListRequest) (*LikeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeList not implemented")
+}
+func (UnimplementedMarkServiceServer) UnlikeList(context.Context, *UnlikeListRequest) (*UnlikeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeList not implemented")
+}
+func (UnimplementedMarkServiceServer) mustEmbedUnimplementedMarkServiceServer() {}
+func (UnimplementedMarkServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeMarkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarkServiceServer will
+// result in compilation errors.
+type UnsafeMarkServiceServer interface {
+	mustEmbedUnimplementedMarkServiceServer()
+}
+
+func RegisterMarkServiceServer(s grpc.ServiceRegistrar, srv MarkServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMarkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MarkService_ServiceDesc, srv)
+}
+
+func _MarkService_GetReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetReviews(ctx, req.(*GetReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_GetMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetMark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetMark(ctx, req.(*GetMarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ListMarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ListMarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ListMarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ListMarks(ctx, req.(*ListMarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_GetReleaseGroupMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseGroupMarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetReleaseGroupMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetReleaseGroupMark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetReleaseGroupMark(ctx, req.(*GetReleaseGroupMarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_GetArtistMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtistMarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetArtistMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetArtistMark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetArtistMark(ctx, req.(*GetArtistMarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Review)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).CreateReview(ctx, req.(*Review))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_Rate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).Rate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_Rate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).Rate(ctx, req.(*RateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ListReviewRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ListReviewRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ListReviewRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ListReviewRevisions(ctx, req.(*ListReviewRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_LikeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).LikeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_LikeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).LikeReview(ctx, req.(*LikeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_UnlikeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).UnlikeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_UnlikeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).UnlikeReview(ctx, req.(*UnlikeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ListReviewLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ListReviewLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ListReviewLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ListReviewLikers(ctx, req.(*ListReviewLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ReportReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ReportReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ReportReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ReportReview(ctx, req.(*ReportReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_HideReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).HideReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_HideReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).HideReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_RestoreReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).RestoreReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_RestoreReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).RestoreReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ResolveReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ResolveReports(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_GetChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetChart(ctx, req.(*GetChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarkService_CreateDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiaryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).CreateDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_CreateDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).CreateDiaryEntry(ctx, req.(*CreateDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *markServiceClient) IncLike(ctx context.Context, in *IncLikeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, MarkService_IncLike_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MarkService_EditDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditDiaryEntryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MarkServiceServer).EditDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_EditDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).EditDiaryEntry(ctx, req.(*EditDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *markServiceClient) DecLike(ctx context.Context, in *DecLikeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, MarkService_DecLike_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MarkService_DeleteDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDiaryEntryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MarkServiceServer).DeleteDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_DeleteDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).DeleteDiaryEntry(ctx, req.(*DeleteDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// MarkServiceServer is the server API for MarkService service.
-// All implementations must embed UnimplementedMarkServiceServer
-// for forward compatibility.
-type MarkServiceServer interface {
-	GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error)
-	DeleteReview(context.Context, *DeleteReviewRequest) (*emptypb.Empty, error)
-	GetMark(context.Context, *GetMarkRequest) (*Mark, error)
-	CreateReview(context.Context, *Review) (*emptypb.Empty, error)
-	IncLike(context.Context, *IncLikeRequest) (*emptypb.Empty, error)
-	DecLike(context.Context, *DecLikeRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedMarkServiceServer()
+func _MarkService_ListDiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).ListDiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_ListDiary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).ListDiary(ctx, req.(*ListDiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedMarkServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMarkServiceServer struct{}
-
-func (UnimplementedMarkServiceServer) GetReviews(context.Context, *GetReviewsRequest) (*GetReviewsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReviews not implemented")
-}
-func (UnimplementedMarkServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+func _MarkService_GetDiaryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiaryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetDiaryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetDiaryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetDiaryStats(ctx, req.(*GetDiaryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMarkServiceServer) GetMark(context.Context, *GetMarkRequest) (*Mark, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMark not implemented")
+
+func _MarkService_CreateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).CreateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_CreateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).CreateList(ctx, req.(*CreateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMarkServiceServer) CreateReview(context.Context, *Review) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+
+func _MarkService_UpdateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).UpdateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_UpdateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).UpdateList(ctx, req.(*UpdateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMarkServiceServer) IncLike(context.Context, *IncLikeRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncLike not implemented")
+
+func _MarkService_DeleteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).DeleteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_DeleteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).DeleteList(ctx, req.(*DeleteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMarkServiceServer) DecLike(context.Context, *DecLikeRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecLike not implemented")
+
+func _MarkService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).GetList(ctx, req.(*GetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMarkServiceServer) mustEmbedUnimplementedMarkServiceServer() {}
-func (UnimplementedMarkServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeMarkServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MarkServiceServer will
-// result in compilation errors.
-type UnsafeMarkServiceServer interface {
-	mustEmbedUnimplementedMarkServiceServer()
+func _MarkService_BrowseLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrowseListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarkServiceServer).BrowseLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_BrowseLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).BrowseLists(ctx, req.(*BrowseListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMarkServiceServer(s grpc.ServiceRegistrar, srv MarkServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMarkServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MarkService_AddListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MarkService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MarkServiceServer).AddListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarkService_AddListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarkServiceServer).AddListItem(ctx, req.(*AddListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MarkService_GetReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReviewsRequest)
+func _MarkService_EditListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditListItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarkServiceServer).GetReviews(ctx, in)
+		return srv.(MarkServiceServer).EditListItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MarkService_GetReviews_FullMethodName,
+		FullMethod: MarkService_EditListItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarkServiceServer).GetReviews(ctx, req.(*GetReviewsRequest))
+		return srv.(MarkServiceServer).EditListItem(ctx, req.(*EditListItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MarkService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReviewRequest)
+func _MarkService_MoveListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveListItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarkServiceServer).DeleteReview(ctx, in)
+		return srv.(MarkServiceServer).MoveListItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MarkService_DeleteReview_FullMethodName,
+		FullMethod: MarkService_MoveListItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarkServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+		return srv.(MarkServiceServer).MoveListItem(ctx, req.(*MoveListItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MarkService_GetMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMarkRequest)
+func _MarkService_RemoveListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveListItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarkServiceServer).GetMark(ctx, in)
+		return srv.(MarkServiceServer).RemoveListItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MarkService_GetMark_FullMethodName,
+		FullMethod: MarkService_RemoveListItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarkServiceServer).GetMark(ctx, req.(*GetMarkRequest))
+		return srv.(MarkServiceServer).RemoveListItem(ctx, req.(*RemoveListItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MarkService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Review)
+func _MarkService_GetListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarkServiceServer).CreateReview(ctx, in)
+		return srv.(MarkServiceServer).GetListItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MarkService_CreateReview_FullMethodName,
+		FullMethod: MarkService_GetListItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarkServiceServer).CreateReview(ctx, req.(*Review))
+		return srv.(MarkServiceServer).GetListItems(ctx, req.(*GetListItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MarkService_IncLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncLikeRequest)
+func _MarkService_LikeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarkServiceServer).IncLike(ctx, in)
+		return srv.(MarkServiceServer).LikeList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MarkService_IncLike_FullMethodName,
+		FullMethod: MarkService_LikeList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarkServiceServer).IncLike(ctx, req.(*IncLikeRequest))
+		return srv.(MarkServiceServer).LikeList(ctx, req.(*LikeListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MarkService_DecLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecLikeRequest)
+func _MarkService_UnlikeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarkServiceServer).DecLike(ctx, in)
+		return srv.(MarkServiceServer).UnlikeList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MarkService_DecLike_FullMethodName,
+		FullMethod: MarkService_UnlikeList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarkServiceServer).DecLike(ctx, req.(*DecLikeRequest))
+		return srv.(MarkServiceServer).UnlikeList(ctx, req.(*UnlikeListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -294,17 +1498,153 @@ var MarkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMark",
 			Handler:    _MarkService_GetMark_Handler,
 		},
+		{
+			MethodName: "ListMarks",
+			Handler:    _MarkService_ListMarks_Handler,
+		},
+		{
+			MethodName: "GetReleaseGroupMark",
+			Handler:    _MarkService_GetReleaseGroupMark_Handler,
+		},
+		{
+			MethodName: "GetArtistMark",
+			Handler:    _MarkService_GetArtistMark_Handler,
+		},
 		{
 			MethodName: "CreateReview",
 			Handler:    _MarkService_CreateReview_Handler,
 		},
 		{
-			MethodName: "IncLike",
-			Handler:    _MarkService_IncLike_Handler,
+			MethodName: "UpdateReview",
+			Handler:    _MarkService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "Rate",
+			Handler:    _MarkService_Rate_Handler,
+		},
+		{
+			MethodName: "ListReviewRevisions",
+			Handler:    _MarkService_ListReviewRevisions_Handler,
+		},
+		{
+			MethodName: "LikeReview",
+			Handler:    _MarkService_LikeReview_Handler,
+		},
+		{
+			MethodName: "UnlikeReview",
+			Handler:    _MarkService_UnlikeReview_Handler,
+		},
+		{
+			MethodName: "ListReviewLikers",
+			Handler:    _MarkService_ListReviewLikers_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _MarkService_CreateComment_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _MarkService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _MarkService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _MarkService_ListComments_Handler,
+		},
+		{
+			MethodName: "ReportReview",
+			Handler:    _MarkService_ReportReview_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _MarkService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "HideReview",
+			Handler:    _MarkService_HideReview_Handler,
+		},
+		{
+			MethodName: "RestoreReview",
+			Handler:    _MarkService_RestoreReview_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _MarkService_ResolveReports_Handler,
+		},
+		{
+			MethodName: "GetChart",
+			Handler:    _MarkService_GetChart_Handler,
+		},
+		{
+			MethodName: "CreateDiaryEntry",
+			Handler:    _MarkService_CreateDiaryEntry_Handler,
+		},
+		{
+			MethodName: "EditDiaryEntry",
+			Handler:    _MarkService_EditDiaryEntry_Handler,
+		},
+		{
+			MethodName: "DeleteDiaryEntry",
+			Handler:    _MarkService_DeleteDiaryEntry_Handler,
+		},
+		{
+			MethodName: "ListDiary",
+			Handler:    _MarkService_ListDiary_Handler,
+		},
+		{
+			MethodName: "GetDiaryStats",
+			Handler:    _MarkService_GetDiaryStats_Handler,
+		},
+		{
+			MethodName: "CreateList",
+			Handler:    _MarkService_CreateList_Handler,
+		},
+		{
+			MethodName: "UpdateList",
+			Handler:    _MarkService_UpdateList_Handler,
+		},
+		{
+			MethodName: "DeleteList",
+			Handler:    _MarkService_DeleteList_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _MarkService_GetList_Handler,
+		},
+		{
+			MethodName: "BrowseLists",
+			Handler:    _MarkService_BrowseLists_Handler,
+		},
+		{
+			MethodName: "AddListItem",
+			Handler:    _MarkService_AddListItem_Handler,
+		},
+		{
+			MethodName: "EditListItem",
+			Handler:    _MarkService_EditListItem_Handler,
+		},
+		{
+			MethodName: "MoveListItem",
+			Handler:    _MarkService_MoveListItem_Handler,
+		},
+		{
+			MethodName: "RemoveListItem",
+			Handler:    _MarkService_RemoveListItem_Handler,
+		},
+		{
+			MethodName: "GetListItems",
+			Handler:    _MarkService_GetListItems_Handler,
+		},
+		{
+			MethodName: "LikeList",
+			Handler:    _MarkService_LikeList_Handler,
 		},
 		{
-			MethodName: "DecLike",
-			Handler:    _MarkService_DecLike_Handler,
+			MethodName: "UnlikeList",
+			Handler:    _MarkService_UnlikeList_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -5,20 +5,29 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/mark/cache"
+	"github.com/osamikoyo/music-and-marks/services/mark/catalog"
+	"github.com/osamikoyo/music-and-marks/services/mark/charts"
 	"github.com/osamikoyo/music-and-marks/services/mark/config"
 	"github.com/osamikoyo/music-and-marks/services/mark/core"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
 	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
 	"github.com/osamikoyo/music-and-marks/services/mark/recounter"
 	"github.com/osamikoyo/music-and-marks/services/mark/repository"
 	"github.com/osamikoyo/music-and-marks/services/mark/server"
+	"github.com/osamikoyo/music-and-marks/services/mark/users"
+	musicpb "github.com/osamikoyo/music-and-marks/services/music/api/proto/gen/pb"
+	userpb "github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -27,6 +36,8 @@ type App struct {
 	grpc      *grpc.Server
 	logger    *logger.Logger
 	recounter *recounter.Recounter
+	charter   *charts.Charter
+	core      *core.Core
 	cfg       *config.Config
 }
 
@@ -51,7 +62,7 @@ func SetupApp(configPath string) (*App, error) {
 		return nil, fmt.Errorf("failed load config: %s: %w", configPath, err)
 	}
 
-	db, err := gorm.Open(sqlite.Open(cfg.DBAddr))
+	db, err := gorm.Open(sqlite.Open(cfg.DBAddr), &gorm.Config{TranslateError: true})
 	if err != nil {
 		logger.Error("failed open db",
 			zap.String("path", cfg.DBAddr),
@@ -65,13 +76,54 @@ func SetupApp(configPath string) (*App, error) {
 
 	repo := repository.NewRepository(db, logger)
 
+	merged, err := migrate(db, repo)
+	if err != nil {
+		logger.Error("failed migrate db",
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed migrate db: %w", err)
+	}
+
 	cache := cache.NewCache(cfg, logger)
 
-	recounter, client := recounter.NewRecounter(cache, repo, logger)
+	recounter, client := recounter.NewRecounter(cfg, repo, cache, recounter.SystemClock, logger)
+
+	if err = recounter.Reconcile(context.Background()); err != nil {
+		logger.Error("failed reconcile marks",
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed reconcile marks: %w", err)
+	}
+
+	conn, err := grpc.NewClient(cfg.UserServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("failed connect to user service",
+			zap.String("addr", cfg.UserServiceAddr),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed connect to user service: %w", err)
+	}
+
+	users := users.NewClient(userpb.NewUserServiceClient(conn), logger)
+
+	uncountMergedReviews(users, merged, cfg.RepoTimeout, logger)
+
+	musicConn, err := grpc.NewClient(cfg.MusicServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("failed connect to music service",
+			zap.String("addr", cfg.MusicServiceAddr),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed connect to music service: %w", err)
+	}
+
+	catalog := catalog.NewClient(musicpb.NewMusicServiceClient(musicConn), logger)
 
-	core := core.NewCore(repo, cache, client, cfg.RepoTimeout)
+	core := core.NewCore(repo, cache, client, users, catalog, cfg.Moderation.AutoHideReports, cfg.Rating.MinVotes, cfg.Charts.Size, cfg.RepoTimeout, logger)
+	verifier, _ := authz.NewRemoteVerifier(cfg.JwksURL, cfg.HS256Secret())
+	interceptor := authz.UnaryServerInterceptor(verifier, server.Policy)
 	server := server.NewServer(core, logger)
-	grpcsrv := grpc.NewServer()
+	grpcsrv := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
 	pb.RegisterMarkServiceServer(grpcsrv, server)
 
 	metrics.InitMetrics()
@@ -80,9 +132,65 @@ func SetupApp(configPath string) (*App, error) {
 		grpc:      grpcsrv,
 		logger:    logger,
 		recounter: recounter,
+		charter:   charts.NewCharter(cfg, repo, cache, logger),
+		core:      core,
+		cfg:       cfg,
 	}, nil
 }
 
+// migrate folds duplicate reviews into revisions and drops duplicate marks
+// before the unique indexes on both tables are created. The marks are
+// recounted by the recounter afterwards; the reviews merged away are
+// returned by user so their counters can be taken back.
+func migrate(db *gorm.DB, repo *repository.Repository) (map[string]int, error) {
+	if err := db.AutoMigrate(&entity.ReviewRevision{}, &entity.ReviewLike{}, &entity.Comment{}, &entity.ReleaseParent{}, &entity.ReviewReport{}, &entity.ModerationLog{}, &entity.ReleaseGenre{}, &entity.ChartEntry{}, &entity.DiaryEntry{}, &entity.List{}, &entity.ListItem{}, &entity.ListLike{}); err != nil {
+		return nil, err
+	}
+
+	var merged map[string]int
+
+	if db.Migrator().HasTable(&entity.Review{}) {
+		var err error
+
+		if merged, err = repo.MergeDuplicateReviews(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := db.AutoMigrate(&entity.Review{}); err != nil {
+		return nil, err
+	}
+
+	if db.Migrator().HasTable(&entity.Mark{}) {
+		if err := repo.DedupeMarks(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, db.AutoMigrate(&entity.Mark{})
+}
+
+// uncountMergedReviews takes the reviews merged by migrate off the counters
+// of their authors. The merge already happened, so failures are only
+// logged.
+func uncountMergedReviews(users *users.Client, merged map[string]int, timeout time.Duration, logger *logger.Logger) {
+	for userID, n := range merged {
+		for range n {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			err := users.DecReview(ctx, userID)
+			cancel()
+
+			if err != nil {
+				logger.Warn("failed uncount merged reviews",
+					zap.String("user_id", userID),
+					zap.Int("reviews", n))
+
+				break
+			}
+		}
+	}
+}
+
 func (a *App) Run(appctx context.Context) error {
 	a.logger.Info("starting app...")
 
@@ -103,6 +211,19 @@ func (a *App) Run(appctx context.Context) error {
 		return nil
 	})
 
+	// releases reviewed while the music service was unreachable, then the
+	// charts, which are filtered by the metadata placing looks up
+	eg.Go(func() error {
+		if err := a.core.PlaceReleases(ctx); err != nil {
+			a.logger.Warn("failed place reviewed releases",
+				zap.Error(err))
+		}
+
+		a.charter.Start(ctx)
+
+		return nil
+	})
+
 	http.Handle("/metrics", promhttp.Handler())
 
 	eg.Go(func() error {
@@ -36,30 +36,156 @@ func (c *Cache) Set(key string, value interface{}) {
 	c.cache.Set(key, value, cache.DefaultExpiration)
 }
 
-func (c *Cache) GetReviews(key string) ([]entity.Review, error) {
+func (c *Cache) Delete(key string) {
+	c.logger.Info("deleting value",
+		zap.String("key", key))
+
+	c.cache.Delete(key)
+}
+
+// reviewPages are the cached first pages of the review listings of one
+// release by filter. They live under the release id, so deleting it drops
+// them all; at most maxReviewPages are kept per release.
+type reviewPages map[string]*entity.ReviewPage
+
+const maxReviewPages = 32
 
-	c.logger.Info("fetching reviews from cache",
+func (c *Cache) GetReviewPage(releaseID, key string) (*entity.ReviewPage, error) {
+	c.logger.Info("fetching review page from cache",
+		zap.String("release_id", releaseID),
 		zap.String("key", key))
 
-	value, ok := c.cache.Get(key)
+	value, ok := c.cache.Get(releaseID)
 	if !ok {
-		c.logger.Error("failed fetch reviews from cache",
-			zap.String("key", key))
+		return nil, ErrCache
+	}
+
+	pages, ok := value.(reviewPages)
+	if !ok {
+		c.logger.Error("failed convert cache value to review pages",
+			zap.String("release_id", releaseID),
+			zap.Any("value", value))
+
+		return nil, ErrConvertFail
+	}
+
+	page, ok := pages[key]
+	if !ok {
+		return nil, ErrCache
+	}
+
+	return page, nil
+}
+
+// SetReviewPage adds a page to the cached pages of the release. The pages
+// are copied rather than changed in place since readers share them.
+func (c *Cache) SetReviewPage(releaseID, key string, page *entity.ReviewPage) {
+	c.logger.Info("setting review page",
+		zap.String("release_id", releaseID),
+		zap.String("key", key))
+
+	pages, _ := c.cache.Get(releaseID)
+	old, _ := pages.(reviewPages)
+
+	if len(old) >= maxReviewPages {
+		old = nil
+	}
+
+	next := make(reviewPages, len(old)+1)
+	for k, v := range old {
+		next[k] = v
+	}
+
+	next[key] = page
+
+	c.cache.Set(releaseID, next, cache.DefaultExpiration)
+}
+
+// ReleaseGroupMarkKey and ArtistMarkKey are the keys aggregated marks are
+// cached under; release ids are used as they are for reviews.
+func ReleaseGroupMarkKey(id string) string {
+	return "release-group:" + id
+}
+
+func ArtistMarkKey(id string) string {
+	return "artist:" + id
+}
 
+func (c *Cache) GetMark(key string) (*entity.Mark, error) {
+	c.logger.Info("fetching mark from cache",
+		zap.String("key", key))
+
+	value, ok := c.cache.Get(key)
+	if !ok {
 		return nil, ErrCache
 	}
 
-	reviews, ok := value.([]entity.Review)
+	mark, ok := value.(*entity.Mark)
 	if !ok {
-		c.logger.Error("failed convert cache value to reviews",
+		c.logger.Error("failed convert cache value to mark",
 			zap.String("key", key),
 			zap.Any("value", value))
 
 		return nil, ErrConvertFail
 	}
 
-	c.logger.Info("reviews fetched from cache successfully",
-		zap.Any("reviews", reviews))
+	return mark, nil
+}
+
+// chartPages are the cached first pages of the chart listings by chart and
+// filter. They live under ChartPagesKey, so deleting it when the charts are
+// rebuilt drops them all; at most maxChartPages are kept.
+type chartPages map[string]*entity.ChartPage
+
+const (
+	ChartPagesKey = "charts"
+	maxChartPages = 256
+)
+
+func (c *Cache) GetChartPage(key string) (*entity.ChartPage, error) {
+	c.logger.Info("fetching chart page from cache",
+		zap.String("key", key))
+
+	value, ok := c.cache.Get(ChartPagesKey)
+	if !ok {
+		return nil, ErrCache
+	}
+
+	pages, ok := value.(chartPages)
+	if !ok {
+		c.logger.Error("failed convert cache value to chart pages",
+			zap.Any("value", value))
+
+		return nil, ErrConvertFail
+	}
+
+	page, ok := pages[key]
+	if !ok {
+		return nil, ErrCache
+	}
+
+	return page, nil
+}
+
+// SetChartPage adds a page to the cached chart pages, copying them like
+// SetReviewPage does.
+func (c *Cache) SetChartPage(key string, page *entity.ChartPage) {
+	c.logger.Info("setting chart page",
+		zap.String("key", key))
+
+	pages, _ := c.cache.Get(ChartPagesKey)
+	old, _ := pages.(chartPages)
+
+	if len(old) >= maxChartPages {
+		old = nil
+	}
+
+	next := make(chartPages, len(old)+1)
+	for k, v := range old {
+		next[k] = v
+	}
+
+	next[key] = page
 
-	return reviews, nil
+	c.cache.Set(ChartPagesKey, next, cache.DefaultExpiration)
 }
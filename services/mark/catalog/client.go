@@ -0,0 +1,76 @@
+// Package catalog looks up where releases belong in the catalog of the
+// music service.
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/music/api/proto/gen/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUnknownRelease means the music service has no release with the id.
+var ErrUnknownRelease = errors.New("unknown release")
+
+type Client struct {
+	cc     pb.MusicServiceClient
+	logger *logger.Logger
+}
+
+func NewClient(cc pb.MusicServiceClient, logger *logger.Logger) *Client {
+	return &Client{
+		cc:     cc,
+		logger: logger,
+	}
+}
+
+// ReleaseParent returns the release group and artist of the release along
+// with the metadata charts are filtered by.
+func (c *Client) ReleaseParent(ctx context.Context, releaseID string) (*entity.ReleaseParent, error) {
+	resp, err := c.cc.GetRelease(ctx, &pb.GetReleaseRequest{Id: releaseID})
+	if err != nil {
+		c.logger.Error("failed fetch release",
+			zap.String("release_id", releaseID),
+			zap.Error(err))
+
+		if code := status.Code(err); code == codes.NotFound || code == codes.InvalidArgument {
+			return nil, ErrUnknownRelease
+		}
+
+		return nil, fmt.Errorf("failed fetch release: %w", err)
+	}
+
+	release := resp.Release
+
+	// charts go by the year the music first came out
+	year := entity.ParseYear(release.GetFirstReleaseDate())
+	if year == 0 {
+		year = entity.ParseYear(release.GetDate())
+	}
+
+	genres := make([]string, 0, len(release.GetGenres()))
+	for _, genre := range release.GetGenres() {
+		if genre = entity.NormalizeTag(genre); genre != "" && !slices.Contains(genres, genre) {
+			genres = append(genres, genre)
+		}
+	}
+
+	return &entity.ReleaseParent{
+		ReleaseID:      releaseID,
+		ReleaseGroupID: release.GetReleaseGroupId(),
+		ArtistID:       release.GetArtistId(),
+		Year:           year,
+		Country:        strings.ToUpper(release.GetCountry()),
+		PrimaryType:    entity.NormalizeTag(release.GetPrimaryType()),
+		Genres:         genres,
+		Described:      true,
+	}, nil
+}
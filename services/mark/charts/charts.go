@@ -0,0 +1,85 @@
+// Package charts rebuilds the precomputed charts of releases.
+package charts
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/mark/cache"
+	"github.com/osamikoyo/music-and-marks/services/mark/config"
+	"go.uber.org/zap"
+)
+
+const DefaultRebuildInterval = time.Hour
+
+type Repository interface {
+	RebuildCharts(ctx context.Context, minReviews, minVotes int) error
+}
+
+type Cache interface {
+	Delete(key string)
+}
+
+// Charter rebuilds all charts on start and every rebuildInterval after.
+type Charter struct {
+	logger *logger.Logger
+	repo   Repository
+	cache  Cache
+
+	rebuildInterval time.Duration
+
+	// minReviews is the reviews a release needs to enter a chart.
+	minReviews int
+	minVotes   int
+}
+
+func NewCharter(cfg *config.Config, repo Repository, cache Cache, logger *logger.Logger) *Charter {
+	c := &Charter{
+		logger:          logger,
+		repo:            repo,
+		cache:           cache,
+		rebuildInterval: cfg.Charts.RebuildInterval,
+		minReviews:      max(cfg.Charts.MinReviews, 1),
+		minVotes:        max(cfg.Rating.MinVotes, 0),
+	}
+
+	if c.rebuildInterval <= 0 {
+		c.rebuildInterval = DefaultRebuildInterval
+	}
+
+	return c
+}
+
+func (c *Charter) rebuild(ctx context.Context) {
+	if err := c.repo.RebuildCharts(ctx, c.minReviews, c.minVotes); err != nil {
+		c.logger.Error("failed rebuild charts",
+			zap.Error(err))
+
+		return
+	}
+
+	// pages cached before now list the old charts
+	c.cache.Delete(cache.ChartPagesKey)
+}
+
+// Start runs until ctx is done.
+func (c *Charter) Start(ctx context.Context) {
+	c.logger.Info("starting charter...")
+
+	c.rebuild(ctx)
+
+	ticker := time.NewTicker(c.rebuildInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("stopping charter...")
+
+			return
+		case <-ticker.C:
+			c.rebuild(ctx)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -17,7 +18,24 @@ type Config struct {
 
 	DBAddr string `yaml:"db_addr" mapstructure:"db_addr"`
 
+	// UserServiceAddr is where the like counters of review authors live.
+	UserServiceAddr string `yaml:"user_service_addr" mapstructure:"user_service_addr"`
+
+	// MusicServiceAddr is where releases are placed in release groups and
+	// artists.
+	MusicServiceAddr string `yaml:"music_service_addr" mapstructure:"music_service_addr"`
+
+	authz.VerifierConfig `yaml:",inline" mapstructure:",squash"`
+
 	Cache CacheConfig `yaml:"cache" mapstrucure:"cache"`
+
+	Rating RatingConfig `yaml:"rating" mapstructure:"rating"`
+
+	Recounter RecounterConfig `yaml:"recounter" mapstructure:"recounter"`
+
+	Moderation ModerationConfig `yaml:"moderation" mapstructure:"moderation"`
+
+	Charts ChartsConfig `yaml:"charts" mapstructure:"charts"`
 }
 
 type CacheConfig struct {
@@ -25,6 +43,38 @@ type CacheConfig struct {
 	ExpiredItemsPurgeTimeout time.Duration `yaml:"exp_items_purge_timeout" mapstructure:"exp_items_purge_timeout"`
 }
 
+// RatingConfig tunes the weighted marks: MinVotes is the number of reviews
+// scoring the global mean every release starts with, ReweighInterval how
+// often all marks follow the drifting global mean.
+type RatingConfig struct {
+	MinVotes        int           `yaml:"min_votes" mapstructure:"min_votes"`
+	ReweighInterval time.Duration `yaml:"reweigh_interval" mapstructure:"reweigh_interval"`
+}
+
+// RecounterConfig debounces the writes of marks: score changes are written
+// FlushInterval after the first unwritten one, or as soon as FlushSize of
+// them are waiting.
+type RecounterConfig struct {
+	FlushInterval time.Duration `yaml:"flush_interval" mapstructure:"flush_interval"`
+	FlushSize     int           `yaml:"flush_size" mapstructure:"flush_size"`
+}
+
+// ModerationConfig hides a review once AutoHideReports distinct users
+// reported it; 0 turns automatic hiding off.
+type ModerationConfig struct {
+	AutoHideReports int `yaml:"auto_hide_reports" mapstructure:"auto_hide_reports"`
+}
+
+// ChartsConfig tunes the precomputed charts: they are rebuilt every
+// RebuildInterval and rank the releases with at least MinReviews reviews.
+// A chart lists its top Size releases, or the top Size of those matching
+// its filters.
+type ChartsConfig struct {
+	RebuildInterval time.Duration `yaml:"rebuild_interval" mapstructure:"rebuild_interval"`
+	Size            int           `yaml:"size" mapstructure:"size"`
+	MinReviews      int           `yaml:"min_reviews" mapstructure:"min_reviews"`
+}
+
 func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v := viper.New()
 
@@ -37,10 +87,28 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.SetDefault("repo_timeout", 30*time.Second)
 
 	v.SetDefault("db_addr", "storage/marks.db")
+	v.SetDefault("user_service_addr", "localhost:50051")
+	v.SetDefault("music_service_addr", "localhost:50052")
+
+	v.SetDefault("jwt_key", authz.DefaultJwtKey)
+	v.SetDefault("jwks_url", authz.DefaultJwksURL)
+	v.SetDefault("accept_hs256", true)
 
 	v.SetDefault("cache.default_exp_time", 5*time.Minute)
 	v.SetDefault("cache.exp_items_purge_timeout", 10*time.Minute)
 
+	v.SetDefault("rating.min_votes", 10)
+	v.SetDefault("rating.reweigh_interval", 5*time.Minute)
+
+	v.SetDefault("recounter.flush_interval", 2*time.Second)
+	v.SetDefault("recounter.flush_size", 500)
+
+	v.SetDefault("moderation.auto_hide_reports", 5)
+
+	v.SetDefault("charts.rebuild_interval", time.Hour)
+	v.SetDefault("charts.size", 1000)
+	v.SetDefault("charts.min_reviews", 3)
+
 	v.SetEnvPrefix("APP")
 	v.AutomaticEnv()
 
@@ -61,14 +129,36 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.BindEnv("repo_timeout", "APP_REPO_TIMEOUT")
 
 	v.BindEnv("db_addr", "APP_DB_ADDR")
+	v.BindEnv("user_service_addr", "APP_USER_SERVICE_ADDR")
+	v.BindEnv("music_service_addr", "APP_MUSIC_SERVICE_ADDR")
+
+	v.BindEnv("jwt_key", "APP_JWT_KEY")
+	v.BindEnv("jwks_url", "APP_JWKS_URL")
+	v.BindEnv("accept_hs256", "APP_ACCEPT_HS256")
 
 	v.BindEnv("cache.default_exp_time", "APP_CACHE_DEFAULT_EXP_TIME")
 	v.BindEnv("cache.exp_times_purge_timeout", "APP_CACHE_EXP_ITEMS_PURGE_TIMEOUT")
 
+	v.BindEnv("rating.min_votes", "APP_RATING_MIN_VOTES")
+	v.BindEnv("rating.reweigh_interval", "APP_RATING_REWEIGH_INTERVAL")
+
+	v.BindEnv("recounter.flush_interval", "APP_RECOUNTER_FLUSH_INTERVAL")
+	v.BindEnv("recounter.flush_size", "APP_RECOUNTER_FLUSH_SIZE")
+
+	v.BindEnv("moderation.auto_hide_reports", "APP_MODERATION_AUTO_HIDE_REPORTS")
+
+	v.BindEnv("charts.rebuild_interval", "APP_CHARTS_REBUILD_INTERVAL")
+	v.BindEnv("charts.size", "APP_CHARTS_SIZE")
+	v.BindEnv("charts.min_reviews", "APP_CHARTS_MIN_REVIEWS")
+
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("failed unmarshal config: %w", err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &cfg, nil
 }
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/cache"
+	"github.com/osamikoyo/music-and-marks/services/mark/catalog"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+)
+
+// Catalog places releases in release groups and artists.
+type Catalog interface {
+	ReleaseParent(ctx context.Context, releaseID string) (*entity.ReleaseParent, error)
+}
+
+// placeRelease looks up the parents and metadata of a release unless they
+// are known.
+func (c *Core) placeRelease(ctx context.Context, releaseID string) error {
+	parents, err := c.repo.ListReleaseParents(ctx, []string{releaseID})
+	if err != nil || len(parents) > 0 && parents[0].Described {
+		return err
+	}
+
+	parent, err := c.catalog.ReleaseParent(ctx, releaseID)
+	if err != nil {
+		return err
+	}
+
+	if err = c.repo.SaveReleaseParent(ctx, parent); err != nil {
+		return err
+	}
+
+	// aggregates cached before now miss the reviews of the release
+	c.cache.Delete(cache.ReleaseGroupMarkKey(parent.ReleaseGroupID))
+	c.cache.Delete(cache.ArtistMarkKey(parent.ArtistID))
+
+	return nil
+}
+
+// PlaceReleases looks up the parents of all reviewed or logged releases
+// that were not placed at the time, or placed before their metadata was
+// kept. Releases missing from the catalog are skipped; it stops at any other
+// failure.
+func (c *Core) PlaceReleases(ctx context.Context) error {
+	releaseIDs, err := c.repo.ListUnplacedReleases(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, releaseID := range releaseIDs {
+		err := c.placeRelease(ctx, releaseID)
+		if errors.Is(err, catalog.ErrUnknownRelease) {
+			c.logger.Warn("skipped release missing from the catalog",
+				zap.String("release_id", releaseID))
+
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Core) GetReleaseGroupMark(releaseGroupID string) (*entity.Mark, error) {
+	return c.aggregateMark(cache.ReleaseGroupMarkKey(releaseGroupID), releaseGroupID, c.repo.GetReleaseGroupMark)
+}
+
+func (c *Core) GetArtistMark(artistID string) (*entity.Mark, error) {
+	return c.aggregateMark(cache.ArtistMarkKey(artistID), artistID, c.repo.GetArtistMark)
+}
+
+func (c *Core) aggregateMark(key, id string, sum func(ctx context.Context, id string, minVotes int) (*entity.Mark, error)) (*entity.Mark, error) {
+	if len(id) == 0 {
+		return nil, ErrEmptyCatalogID
+	}
+
+	mark, err := c.cache.GetMark(key)
+	if err == nil {
+		return mark, nil
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if mark, err = sum(ctx, id, c.minVotes); err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(key, mark)
+
+	return mark, nil
+}
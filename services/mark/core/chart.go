@@ -0,0 +1,130 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+)
+
+var (
+	ErrInvalidChart   = errors.New(`period must be "all_time", "year", "decade", "week" or "month", year and decade need a year`)
+	ErrInvalidCountry = errors.New("country must be an ISO 3166 code")
+)
+
+// DefaultChartSize is the number of entries a chart lists by default.
+const DefaultChartSize = 1000
+
+// chartCacheKey is the key the first page of a chart is cached under.
+func chartCacheKey(chart entity.Chart, filter entity.ChartFilter, pageSize int) string {
+	return fmt.Sprintf("chart:%s/%+v/%d", chart.Key(), filter, pageSize)
+}
+
+// ParseChart names a chart by its period; an empty period is the all time
+// chart. Decades are named by any of their years.
+func ParseChart(period string, year int) (entity.Chart, error) {
+	chart := entity.Chart{Period: entity.ChartPeriod(strings.ToLower(strings.TrimSpace(period)))}
+
+	switch chart.Period {
+	case "":
+		chart.Period = entity.ChartAllTime
+	case entity.ChartAllTime, entity.ChartWeek, entity.ChartMonth:
+	case entity.ChartYear:
+		chart.Year = year
+	case entity.ChartDecade:
+		chart.Year = year - year%10
+	default:
+		return entity.Chart{}, ErrInvalidChart
+	}
+
+	if (chart.Period == entity.ChartYear || chart.Period == entity.ChartDecade) && year <= 0 {
+		return entity.Chart{}, ErrInvalidChart
+	}
+
+	return chart, nil
+}
+
+// GetChart returns one page of the chart narrowed down by the filter; the
+// listing ends after the top chartSize entries. First pages are cached
+// until the charts are rebuilt.
+func (c *Core) GetChart(chart entity.Chart, filter entity.ChartFilter, pageSize int, pageToken string) (*entity.ChartPage, error) {
+	filter.Genre = entity.NormalizeTag(filter.Genre)
+	filter.PrimaryType = entity.NormalizeTag(filter.PrimaryType)
+	filter.Country = strings.ToUpper(strings.TrimSpace(filter.Country))
+
+	if n := len(filter.Country); n != 0 && n != 2 {
+		return nil, ErrInvalidCountry
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.ChartCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeChartPageToken(pageToken, chart, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		after = cursor
+	}
+
+	key := chartCacheKey(chart, filter, pageSize)
+
+	if after == nil {
+		if page, err := c.cache.GetChartPage(key); err == nil {
+			return page, nil
+		}
+	}
+
+	position := 0
+	if after != nil {
+		position = after.Position
+	}
+
+	limit := min(pageSize, c.chartSize-position)
+	if limit <= 0 {
+		return &entity.ChartPage{}, nil
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	// one extra entry tells whether another page exists
+	entries, err := c.repo.ListChart(ctx, chart, filter, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Position = position + i + 1
+	}
+
+	page := &entity.ChartPage{Entries: entries}
+
+	if len(entries) > limit {
+		page.Entries = entries[:limit]
+
+		if position+limit < c.chartSize {
+			if page.NextPageToken, err = encodeChartPageToken(chart, filter, &page.Entries[limit-1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(page.Entries) > 0 {
+		page.ComputedAt = page.Entries[0].CreatedAt
+	}
+
+	if after == nil {
+		c.cache.SetChartPage(key, page)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,241 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/repository"
+)
+
+var (
+	ErrInvalidComment   = errors.New("comment must be 1 to 2000 characters")
+	ErrCommentForbidden = errors.New("comment belongs to another user")
+	ErrInvalidParent    = errors.New("parent comment belongs to another review or was deleted")
+	ErrThreadTooDeep    = errors.New("thread is too deep to reply")
+)
+
+const (
+	maxCommentLen = 2000
+
+	// MaxCommentDepth is the depth of the deepest reply.
+	MaxCommentDepth = 8
+
+	DefaultListDepth = 2
+	MaxListDepth     = 4
+
+	// MaxListedReplies is the number of replies to one comment listed under
+	// it at most; the rest are paged through with the comment as parent.
+	MaxListedReplies = 10
+)
+
+func validComment(text string) bool {
+	length := utf8.RuneCountInString(strings.TrimSpace(text))
+
+	return length > 0 && length <= maxCommentLen
+}
+
+// CreateComment comments on the review, or replies to parentID when it is
+// not 0.
+func (c *Core) CreateComment(reviewID, parentID uint, userID, text string) (*entity.Comment, error) {
+	if !validComment(text) {
+		return nil, ErrInvalidComment
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.repo.GetReviewByID(ctx, reviewID); err != nil {
+		return nil, err
+	}
+
+	var parent *entity.Comment
+
+	if parentID != 0 {
+		found, err := c.repo.GetComment(ctx, parentID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, ErrInvalidParent
+			}
+
+			return nil, err
+		}
+
+		if found.ReviewID != reviewID || found.Deleted {
+			return nil, ErrInvalidParent
+		}
+
+		if found.Depth >= MaxCommentDepth {
+			return nil, ErrThreadTooDeep
+		}
+
+		parent = found
+	}
+
+	comment := entity.NewComment(reviewID, parent, userID, text)
+
+	if err := c.repo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (c *Core) EditComment(id uint, userID, text string) (*entity.Comment, error) {
+	if !validComment(text) {
+		return nil, ErrInvalidComment
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	comment, err := c.getComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != userID {
+		return nil, ErrCommentForbidden
+	}
+
+	if comment.Text == text {
+		return comment, nil
+	}
+
+	now := time.Now()
+
+	comment.Text = text
+	comment.EditedAt = &now
+
+	if err = c.repo.EditComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// DeleteComment removes a comment on behalf of its author; moderators may
+// pass deleteAny to remove comments of other users.
+func (c *Core) DeleteComment(id uint, userID string, deleteAny bool) error {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	comment, err := c.getComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID && !deleteAny {
+		return ErrCommentForbidden
+	}
+
+	return c.repo.DeleteComment(ctx, comment)
+}
+
+// getComment hides deleted comments kept for their replies.
+func (c *Core) getComment(ctx context.Context, id uint) (*entity.Comment, error) {
+	comment, err := c.repo.GetComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Deleted {
+		return nil, repository.ErrNotFound
+	}
+
+	return comment, nil
+}
+
+// ListComments returns one page of the comments matching the filter, each
+// followed by its replies down to depth levels below it, and the token of
+// the next page (empty on the last page). A negative depth picks the
+// default.
+func (c *Core) ListComments(filter entity.CommentFilter, depth, pageSize int, pageToken string) ([]entity.Comment, string, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	switch {
+	case depth < 0:
+		depth = DefaultListDepth
+	case depth > MaxListDepth:
+		depth = MaxListDepth
+	}
+
+	var after *entity.CommentCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeCommentsPageToken(pageToken, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.repo.GetReviewByID(ctx, filter.ReviewID); err != nil {
+		return nil, "", err
+	}
+
+	// one extra row tells whether another page exists
+	comments, err := c.repo.ListComments(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+
+		if next, err = encodeCommentsPageToken(filter, &comments[pageSize-1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	replies := make(map[uint][]entity.Comment)
+	level := comments
+
+	for range depth {
+		var ids []uint
+
+		for _, comment := range level {
+			if comment.Replies > 0 {
+				ids = append(ids, comment.ID)
+			}
+		}
+
+		if len(ids) == 0 {
+			break
+		}
+
+		if level, err = c.repo.ListReplies(ctx, ids, MaxListedReplies, MaxPageSize); err != nil {
+			return nil, "", err
+		}
+
+		for _, reply := range level {
+			replies[*reply.ParentID] = append(replies[*reply.ParentID], reply)
+		}
+	}
+
+	return thread(comments, replies, nil), next, nil
+}
+
+// thread lays the comments out depth first, every comment followed by its
+// replies.
+func thread(comments []entity.Comment, replies map[uint][]entity.Comment, out []entity.Comment) []entity.Comment {
+	for _, comment := range comments {
+		out = append(out, comment)
+		out = thread(replies[comment.ID], replies, out)
+	}
+
+	return out
+}
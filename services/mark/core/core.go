@@ -2,95 +2,442 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/mark/catalog"
 	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/repository"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrForbidden        = errors.New("review belongs to another user")
+	ErrReviewExists     = errors.New("release is already reviewed, edit the review instead")
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidScore     = errors.New("score must be between 0 and 10")
+	ErrInvalidOrder     = errors.New("invalid order")
+	ErrEmptyCatalogID   = errors.New("empty release group or artist id")
+	ErrInvalidKind      = errors.New(`kind must be "text", "score" or "all"`)
+	ErrInvalidRange     = errors.New("min score is above max score")
+	ErrInvalidLanguage  = errors.New("language must be an ISO 639 code")
+	ErrUnknownRelease   = errors.New("release does not exist in the catalog")
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
 )
 
 type Repository interface {
 	CreateReview(ctx context.Context, review *entity.Review) error
 	UpdateReview(ctx context.Context, id uint, update *entity.Review) error
-	DeleteReview(ctx context.Context, id uint) error
-	GetReviewsByReleaseID(ctx context.Context, releaseID string) ([]entity.Review, error)
+	DeleteReview(ctx context.Context, id uint) (int64, error)
+	ListReviews(ctx context.Context, filter entity.ReviewFilter, after *entity.ReviewCursor, limit int) ([]entity.Review, error)
 	GetReviewByID(ctx context.Context, id uint) (*entity.Review, error)
+	GetAnyReviewByID(ctx context.Context, id uint) (*entity.Review, error)
+	GetUserReview(ctx context.Context, userID, releaseID string) (*entity.Review, error)
 	GetMarkByReleaseID(ctx context.Context, releaseID string) (*entity.Mark, error)
-	UpdateMarkByReleaseID(ctx context.Context, releaseID string, update *entity.Mark) error
+	ListMarks(ctx context.Context, filter entity.MarkFilter, after *entity.MarkCursor, limit int) ([]entity.Mark, error)
+	SaveReleaseParent(ctx context.Context, parent *entity.ReleaseParent) error
+	ListReleaseParents(ctx context.Context, releaseIDs []string) ([]entity.ReleaseParent, error)
+	ListUnplacedReleases(ctx context.Context) ([]string, error)
+	GetReleaseGroupMark(ctx context.Context, releaseGroupID string, minVotes int) (*entity.Mark, error)
+	GetArtistMark(ctx context.Context, artistID string, minVotes int) (*entity.Mark, error)
+	EditReview(ctx context.Context, review *entity.Review, revision *entity.ReviewRevision) error
+	LikeReview(ctx context.Context, like *entity.ReviewLike) (int64, bool, error)
+	UnlikeReview(ctx context.Context, reviewID uint, userID string) (int64, bool, error)
+	ListReviewLikes(ctx context.Context, reviewID uint, after *entity.ReviewLikeCursor, limit int) ([]entity.ReviewLike, error)
+	CreateComment(ctx context.Context, comment *entity.Comment) error
+	GetComment(ctx context.Context, id uint) (*entity.Comment, error)
+	EditComment(ctx context.Context, comment *entity.Comment) error
+	DeleteComment(ctx context.Context, comment *entity.Comment) error
+	ListComments(ctx context.Context, filter entity.CommentFilter, after *entity.CommentCursor, limit int) ([]entity.Comment, error)
+	ListReplies(ctx context.Context, parentIDs []uint, perParent, limit int) ([]entity.Comment, error)
+	ListReviewRevisions(ctx context.Context, reviewID uint) ([]entity.ReviewRevision, error)
+	CreateReport(ctx context.Context, report *entity.ReviewReport) (int64, error)
+	HideReview(ctx context.Context, entry *entity.ModerationLog, resolve bool) (*entity.Review, bool, error)
+	RestoreReview(ctx context.Context, entry *entity.ModerationLog) (*entity.Review, bool, error)
+	ResolveReports(ctx context.Context, entry *entity.ModerationLog) (int64, error)
+	ListModerationQueue(ctx context.Context, after *entity.ModerationCursor, limit int) ([]entity.ModerationItem, error)
+	ListChart(ctx context.Context, chart entity.Chart, filter entity.ChartFilter, after *entity.ChartCursor, limit int) ([]entity.ChartEntry, error)
+	CreateDiaryEntry(ctx context.Context, entry *entity.DiaryEntry) error
+	GetDiaryEntry(ctx context.Context, id uint) (*entity.DiaryEntry, error)
+	EditDiaryEntry(ctx context.Context, entry *entity.DiaryEntry) error
+	DeleteDiaryEntry(ctx context.Context, id uint) error
+	ListDiary(ctx context.Context, filter entity.DiaryFilter, after *entity.DiaryCursor, limit int) ([]entity.DiaryEntry, error)
+	GetDiaryStats(ctx context.Context, userID string, year, topArtists int) (*entity.DiaryStats, error)
+	CreateList(ctx context.Context, list *entity.List) error
+	GetList(ctx context.Context, id uint) (*entity.List, error)
+	UpdateList(ctx context.Context, list *entity.List) error
+	DeleteList(ctx context.Context, id uint) error
+	ListLists(ctx context.Context, filter entity.ListFilter, after *entity.ListCursor, limit int) ([]entity.List, error)
+	AddListItem(ctx context.Context, item *entity.ListItem) error
+	GetListItem(ctx context.Context, id uint) (*entity.ListItem, error)
+	EditListItem(ctx context.Context, item *entity.ListItem) error
+	MoveListItem(ctx context.Context, id uint, position int) (*entity.ListItem, error)
+	RemoveListItem(ctx context.Context, item *entity.ListItem) error
+	ListListItems(ctx context.Context, listID uint, after *entity.ListItemCursor, limit int) ([]entity.ListItem, error)
+	LikeList(ctx context.Context, like *entity.ListLike) (int64, bool, error)
+	UnlikeList(ctx context.Context, listID uint, userID string) (int64, bool, error)
 }
 
 type Cache interface {
 	Set(key string, value interface{})
-	GetReviews(key string) ([]entity.Review, error)
+	Delete(key string)
+	GetReviewPage(releaseID, key string) (*entity.ReviewPage, error)
+	SetReviewPage(releaseID, key string, page *entity.ReviewPage)
+	GetMark(key string) (*entity.Mark, error)
+	GetChartPage(key string) (*entity.ChartPage, error)
+	SetChartPage(key string, page *entity.ChartPage)
 }
 
+// Recounter updates the marks of releases as the scores of their reviews
+// change; see recounter.Client.Rescore.
 type Recounter interface {
-	TryRecount(releaseID string)
+	Rescore(releaseID string, removed, added *int)
 }
 
 type Core struct {
 	repo      Repository
 	cache     Cache
 	recounter Recounter
+	users     Users
+	catalog   Catalog
 	timeout   time.Duration
+	logger    *logger.Logger
+
+	// autoHideReports is the number of open reports that hides a review, 0
+	// leaves every review to the moderators.
+	autoHideReports int
+
+	// minVotes is the prior of the weighted release group and artist marks.
+	minVotes int
+
+	// chartSize is the number of entries a chart lists, filtered or not.
+	chartSize int
 }
 
-func NewCore(repo Repository, cache Cache, recounter Recounter, timeout time.Duration) *Core {
-	return &Core{
-		repo:      repo,
-		cache:     cache,
-		recounter: recounter,
-		timeout:   timeout,
+func NewCore(repo Repository, cache Cache, recounter Recounter, users Users, catalog Catalog, autoHideReports, minVotes, chartSize int, timeout time.Duration, logger *logger.Logger) *Core {
+	c := &Core{
+		repo:            repo,
+		cache:           cache,
+		recounter:       recounter,
+		users:           users,
+		catalog:         catalog,
+		autoHideReports: autoHideReports,
+		minVotes:        max(minVotes, 0),
+		chartSize:       chartSize,
+		timeout:         timeout,
+		logger:          logger,
 	}
+
+	if c.chartSize <= 0 {
+		c.chartSize = DefaultChartSize
+	}
+
+	return c
+}
+
+func validScore(score int) bool {
+	return score >= entity.MinScore && score <= entity.MaxScore
+}
+
+// normalizeLanguage lowercases an ISO 639-1 or 639-2 code; the empty
+// language stands for an unknown one.
+func normalizeLanguage(language string) (string, error) {
+	language = strings.ToLower(strings.TrimSpace(language))
+
+	if n := len(language); n != 0 && n != 2 && n != 3 {
+		return "", ErrInvalidLanguage
+	}
+
+	for _, r := range language {
+		if r < 'a' || r > 'z' {
+			return "", ErrInvalidLanguage
+		}
+	}
+
+	return language, nil
 }
 
 func (c *Core) context() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), c.timeout)
 }
 
-func (c *Core) CreateReview(releaseID, text, userID string, count int) error {
-	review := entity.NewReview(releaseID, text, userID, count)
+func (c *Core) CreateReview(releaseID, text, userID, language string, count int) error {
+	if !validScore(count) {
+		return ErrInvalidScore
+	}
+
+	language, err := normalizeLanguage(language)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := c.context()
 	defer cancel()
 
+	return c.createReview(ctx, entity.NewReview(releaseID, text, userID, language, count))
+}
+
+func (c *Core) createReview(ctx context.Context, review *entity.Review) error {
+	// the review counts towards the release group and artist once the
+	// release is placed, PlaceReleases retries on the next start
+	if err := c.placeRelease(ctx, review.ReleaseID); errors.Is(err, catalog.ErrUnknownRelease) {
+		return ErrUnknownRelease
+	}
+
 	if err := c.repo.CreateReview(ctx, review); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExist) {
+			return ErrReviewExists
+		}
+
 		return err
 	}
 
-	reviews, err := c.cache.GetReviews(releaseID)
-	if err != nil {
+	if err := c.users.IncReview(ctx, review.UserID); err != nil {
+		if _, derr := c.repo.DeleteReview(ctx, review.ID); derr != nil {
+			return derr
+		}
+
 		return err
 	}
 
-	newrevs := make([]entity.Review, len(reviews)+1)
-	newrevs[len(reviews)+1] = *review
-
-	c.cache.Set(releaseID, newrevs)
+	c.cache.Delete(review.ReleaseID)
 
-	c.recounter.TryRecount(releaseID)
+	c.recounter.Rescore(review.ReleaseID, nil, &review.Count)
 
 	return nil
 }
 
-func (c *Core) GetReviewsByReleaseID(releaseID string) ([]entity.Review, error) {
+// Rate records the user's score for a release without text. A review the
+// user already wrote keeps its text and only changes its score.
+func (c *Core) Rate(releaseID, userID string, score int) (*entity.Review, error) {
+	if !validScore(score) {
+		return nil, ErrInvalidScore
+	}
+
 	ctx, cancel := c.context()
 	defer cancel()
 
-	reviews, err := c.cache.GetReviews(releaseID)
+	review, err := c.repo.GetUserReview(ctx, userID, releaseID)
 	if err == nil {
-		return reviews, nil
+		if review.HiddenAt != nil {
+			return nil, ErrReviewHidden
+		}
+
+		return c.UpdateReview(review.ID, userID, review.Text, "", score)
+	}
+
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+
+	review = entity.NewReview(releaseID, "", userID, "", score)
+
+	err = c.createReview(ctx, review)
+	if errors.Is(err, ErrReviewExists) {
+		// rated concurrently, the later score wins
+		if review, err = c.repo.GetUserReview(ctx, userID, releaseID); err != nil {
+			return nil, err
+		}
+
+		return c.UpdateReview(review.ID, userID, review.Text, "", score)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// ParseReviewOrder accepts "created_at", "likes" or "score" with an
+// optional " desc" suffix. An empty value lists the newest reviews first.
+func ParseReviewOrder(orderBy string) (entity.ReviewOrder, bool, error) {
+	fields := strings.Fields(orderBy)
+
+	switch len(fields) {
+	case 0:
+		return entity.ReviewOrderCreatedAt, true, nil
+	case 1, 2:
+	default:
+		return "", false, ErrInvalidOrder
+	}
+
+	desc := false
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "desc":
+			desc = true
+		case "asc":
+		default:
+			return "", false, ErrInvalidOrder
+		}
+	}
+
+	switch order := entity.ReviewOrder(fields[0]); order {
+	case entity.ReviewOrderCreatedAt, entity.ReviewOrderLikes, entity.ReviewOrderScore:
+		return order, desc, nil
+	default:
+		return "", false, ErrInvalidOrder
+	}
+}
+
+// ListReviews returns one page of the reviews of a release; score-only
+// ratings are left out unless the filter asks for them. First pages are
+// cached until the reviews of the release change.
+func (c *Core) ListReviews(filter entity.ReviewFilter, pageSize int, pageToken string) (*entity.ReviewPage, error) {
+	switch filter.Kind {
+	case "":
+		filter.Kind = entity.ReviewKindText
+	case entity.ReviewKindText, entity.ReviewKindScore, entity.ReviewKindAll:
+	default:
+		return nil, ErrInvalidKind
+	}
+
+	if !validScore(filter.MinScore) || !validScore(filter.MaxScore) {
+		return nil, ErrInvalidScore
+	}
+
+	if filter.MinScore > filter.MaxScore {
+		return nil, ErrInvalidRange
+	}
+
+	language, err := normalizeLanguage(filter.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Language = language
+
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.ReviewCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeReviewsPageToken(pageToken, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		after = cursor
+	}
+
+	key := fmt.Sprintf("%+v/%d", filter, pageSize)
+
+	if after == nil {
+		if page, err := c.cache.GetReviewPage(filter.ReleaseID, key); err == nil {
+			return page, nil
+		}
 	}
 
-	reviews, err = c.repo.GetReviewsByReleaseID(ctx, releaseID)
+	ctx, cancel := c.context()
+	defer cancel()
+
+	// one extra row tells whether another page exists
+	reviews, err := c.repo.ListReviews(ctx, filter, after, pageSize+1)
 	if err != nil {
 		return nil, err
 	}
 
-	c.cache.Set(releaseID, reviews)
+	page := &entity.ReviewPage{Reviews: reviews}
+
+	if len(reviews) > pageSize {
+		page.Reviews = reviews[:pageSize]
+
+		if page.NextPageToken, err = encodeReviewsPageToken(filter, &page.Reviews[pageSize-1]); err != nil {
+			return nil, err
+		}
+	}
+
+	if after == nil {
+		c.cache.SetReviewPage(filter.ReleaseID, key, page)
+	}
 
-	return reviews, nil
+	return page, nil
 }
 
-func (c *Core) DeleteReview(id uint) error {
+// UpdateReview replaces the text and score of the caller's review and keeps
+// the previous version as a revision. An empty language keeps the current
+// one.
+func (c *Core) UpdateReview(id uint, userID, text, language string, count int) (*entity.Review, error) {
+	if !validScore(count) {
+		return nil, ErrInvalidScore
+	}
+
+	language, err := normalizeLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	review, err := c.repo.GetReviewByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.UserID != userID {
+		return nil, ErrForbidden
+	}
+
+	if language == "" {
+		language = review.Language
+	}
+
+	if review.Text == text && review.Count == count && review.Language == language {
+		return review, nil
+	}
+
+	revision := entity.NewReviewRevision(review)
+
+	now := time.Now()
+
+	review.Text = text
+	review.Count = count
+	review.Language = language
+	review.EditedAt = &now
+
+	if err = c.repo.EditReview(ctx, review, revision); err != nil {
+		return nil, err
+	}
+
+	c.cache.Delete(review.ReleaseID)
+
+	if revision.Count != review.Count {
+		c.recounter.Rescore(review.ReleaseID, &revision.Count, &review.Count)
+	}
+
+	return review, nil
+}
+
+func (c *Core) ListReviewRevisions(reviewID uint) ([]entity.ReviewRevision, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.repo.GetReviewByID(ctx, reviewID); err != nil {
+		return nil, err
+	}
+
+	return c.repo.ListReviewRevisions(ctx, reviewID)
+}
+
+// DeleteReview removes a review on behalf of its author; moderators may
+// pass deleteAny to remove reviews of other users. The author's review
+// counter is taken back first and restored if the review stays; the likes
+// the review received are taken back from it afterwards.
+func (c *Core) DeleteReview(id uint, userID string, deleteAny bool) error {
 	ctx, cancel := c.context()
 	defer cancel()
 
@@ -99,11 +446,38 @@ func (c *Core) DeleteReview(id uint) error {
 		return err
 	}
 
-	if err := c.repo.DeleteReview(ctx, id); err != nil {
+	if review.UserID != userID && !deleteAny {
+		return ErrForbidden
+	}
+
+	if err = c.users.DecReview(ctx, review.UserID); err != nil {
 		return err
 	}
 
-	c.recounter.TryRecount(review.ReleaseID)
+	likes, err := c.repo.DeleteReview(ctx, id)
+	if err != nil {
+		if ierr := c.users.IncReview(ctx, review.UserID); ierr != nil {
+			return ierr
+		}
+
+		return err
+	}
+
+	// the review is gone either way, a counter left behind is only logged
+	for range likes {
+		if err = c.users.DecLike(ctx, review.UserID); err != nil {
+			c.logger.Warn("failed take back likes of deleted review",
+				zap.Uint("review_id", id),
+				zap.String("user_id", review.UserID),
+				zap.Int64("likes", likes))
+
+			break
+		}
+	}
+
+	c.cache.Delete(review.ReleaseID)
+
+	c.recounter.Rescore(review.ReleaseID, &review.Count, nil)
 
 	return nil
 }
@@ -114,36 +488,87 @@ func (c *Core) GetMarkByReleaeID(releaseID string) (*entity.Mark, error) {
 
 	mark, err := c.repo.GetMarkByReleaseID(ctx, releaseID)
 	if err != nil {
+		// releases nobody reviewed yet have an empty mark
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.NewMark(releaseID), nil
+		}
+
 		return nil, err
 	}
 
 	return mark, nil
 }
 
-func (c *Core) IncLike(reviewID uint) error {
-	ctx, cancel := c.context()
-	defer cancel()
-
-	review, err := c.repo.GetReviewByID(ctx, reviewID)
-	if err != nil {
-		return err
+// ParseMarkOrder accepts "weighted", "value" or "reviews" with an optional
+// " desc" suffix. An empty value lists the highest weighted marks first.
+func ParseMarkOrder(orderBy string) (entity.MarkOrder, bool, error) {
+	fields := strings.Fields(orderBy)
+
+	switch len(fields) {
+	case 0:
+		return entity.MarkOrderWeighted, true, nil
+	case 1, 2:
+	default:
+		return "", false, ErrInvalidOrder
 	}
 
-	review.Likes++
+	desc := false
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "desc":
+			desc = true
+		case "asc":
+		default:
+			return "", false, ErrInvalidOrder
+		}
+	}
 
-	return c.repo.UpdateReview(ctx, review.ID, review)
+	switch order := entity.MarkOrder(fields[0]); order {
+	case entity.MarkOrderWeighted, entity.MarkOrderValue, entity.MarkOrderReviews:
+		return order, desc, nil
+	default:
+		return "", false, ErrInvalidOrder
+	}
 }
 
-func (c *Core) DecLike(reviewID uint) error {
+// ListMarks returns one page of marks and the token of the next page (empty
+// on the last page).
+func (c *Core) ListMarks(filter entity.MarkFilter, pageSize int, pageToken string) ([]entity.Mark, string, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.MarkCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeMarksPageToken(pageToken, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
 	ctx, cancel := c.context()
 	defer cancel()
 
-	review, err := c.repo.GetReviewByID(ctx, reviewID)
+	// one extra row tells whether another page exists
+	marks, err := c.repo.ListMarks(ctx, filter, after, pageSize+1)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
-	review.Likes--
+	next := ""
+	if len(marks) > pageSize {
+		marks = marks[:pageSize]
+
+		if next, err = encodeMarksPageToken(filter, &marks[pageSize-1]); err != nil {
+			return nil, "", err
+		}
+	}
 
-	return c.repo.UpdateReview(ctx, review.ID, review)
+	return marks, next, nil
 }
@@ -0,0 +1,224 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/catalog"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/repository"
+)
+
+var (
+	ErrEmptyReleaseID     = errors.New("empty release id")
+	ErrInvalidDate        = errors.New("listened_on must be a date like 2025-03-14, not in the future")
+	ErrInvalidPeriod      = errors.New("year must be set and month between 1 and 12")
+	ErrDiaryForbidden     = errors.New("diary entry belongs to another user")
+	ErrInvalidDiaryReview = errors.New("linked review must be the user's review of the release")
+)
+
+// topArtists is the number of artists diary stats rank.
+const topArtists = 10
+
+// parseListenedOn reads a diary date; an empty one is today. Dates up to
+// a day ahead of UTC are let through for users east of it.
+func parseListenedOn(date string) (time.Time, error) {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return today, nil
+	}
+
+	listenedOn, err := time.Parse(entity.DateLayout, date)
+	if err != nil || listenedOn.After(today.AddDate(0, 0, 1)) {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	return listenedOn, nil
+}
+
+// checkDiaryReview makes sure a linked review is the user's review of the
+// release; a zero reviewID links none.
+func (c *Core) checkDiaryReview(ctx context.Context, reviewID uint, userID, releaseID string) (*uint, error) {
+	if reviewID == 0 {
+		return nil, nil
+	}
+
+	review, err := c.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidDiaryReview
+		}
+
+		return nil, err
+	}
+
+	if review.UserID != userID || review.ReleaseID != releaseID {
+		return nil, ErrInvalidDiaryReview
+	}
+
+	return &reviewID, nil
+}
+
+// CreateDiaryEntry logs a listen of the release by the user. The score,
+// when given, rates that listen only; marks come from reviews.
+func (c *Core) CreateDiaryEntry(userID, releaseID, listenedOn string, score *int, reviewID uint, relisten bool) (*entity.DiaryEntry, error) {
+	if len(releaseID) == 0 {
+		return nil, ErrEmptyReleaseID
+	}
+
+	date, err := parseListenedOn(listenedOn)
+	if err != nil {
+		return nil, err
+	}
+
+	if score != nil && !validScore(*score) {
+		return nil, ErrInvalidScore
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	linked, err := c.checkDiaryReview(ctx, reviewID, userID, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// stats count the entry towards its artist once the release is placed,
+	// PlaceReleases retries on the next start
+	if err = c.placeRelease(ctx, releaseID); errors.Is(err, catalog.ErrUnknownRelease) {
+		return nil, ErrUnknownRelease
+	}
+
+	entry := entity.NewDiaryEntry(userID, releaseID, date, score, linked, relisten)
+
+	if err = c.repo.CreateDiaryEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// EditDiaryEntry replaces the date, score, linked review and relisten flag
+// of the caller's entry; the release stays.
+func (c *Core) EditDiaryEntry(id uint, userID, listenedOn string, score *int, reviewID uint, relisten bool) (*entity.DiaryEntry, error) {
+	date, err := parseListenedOn(listenedOn)
+	if err != nil {
+		return nil, err
+	}
+
+	if score != nil && !validScore(*score) {
+		return nil, ErrInvalidScore
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	entry, err := c.repo.GetDiaryEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.UserID != userID {
+		return nil, ErrDiaryForbidden
+	}
+
+	linked, err := c.checkDiaryReview(ctx, reviewID, userID, entry.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	entry.ListenedOn = date
+	entry.Score = score
+	entry.ReviewID = linked
+	entry.Relisten = relisten
+	entry.EditedAt = &now
+
+	if err = c.repo.EditDiaryEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (c *Core) DeleteDiaryEntry(id uint, userID string) error {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	entry, err := c.repo.GetDiaryEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if entry.UserID != userID {
+		return ErrDiaryForbidden
+	}
+
+	return c.repo.DeleteDiaryEntry(ctx, id)
+}
+
+// ListDiary returns one page of a user's diary for a year, or a month of
+// it, latest listens first.
+func (c *Core) ListDiary(filter entity.DiaryFilter, pageSize int, pageToken string) ([]entity.DiaryEntry, string, error) {
+	if filter.Year <= 0 || filter.Month < 0 || filter.Month > 12 {
+		return nil, "", ErrInvalidPeriod
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.DiaryCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeDiaryPageToken(pageToken, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	// one extra entry tells whether another page exists
+	entries, err := c.repo.ListDiary(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(entries) <= pageSize {
+		return entries, "", nil
+	}
+
+	entries = entries[:pageSize]
+
+	token, err := encodeDiaryPageToken(filter, &entries[pageSize-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entries, token, nil
+}
+
+// GetDiaryStats sums up a user's diary for a year.
+func (c *Core) GetDiaryStats(userID string, year int) (*entity.DiaryStats, error) {
+	if year <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.repo.GetDiaryStats(ctx, userID, year, topArtists)
+}
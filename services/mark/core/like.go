@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+)
+
+var ErrOwnReview = errors.New("authors cannot like their own reviews")
+
+// Users keeps the like and review counters of review authors in the user
+// service.
+type Users interface {
+	IncLike(ctx context.Context, userID string) error
+	DecLike(ctx context.Context, userID string) error
+	IncReview(ctx context.Context, userID string) error
+	DecReview(ctx context.Context, userID string) error
+}
+
+// LikeReview likes the review on behalf of the user and returns its like
+// count. Liking a review twice changes nothing. The author's counter is
+// updated with the like, which is taken back if that fails.
+func (c *Core) LikeReview(reviewID uint, userID string) (int64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	review, err := c.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return 0, err
+	}
+
+	if review.UserID == userID {
+		return 0, ErrOwnReview
+	}
+
+	likes, added, err := c.repo.LikeReview(ctx, entity.NewReviewLike(reviewID, userID))
+	if err != nil {
+		return 0, err
+	}
+
+	if !added {
+		return likes, nil
+	}
+
+	if err = c.users.IncLike(ctx, review.UserID); err != nil {
+		if _, _, rerr := c.repo.UnlikeReview(ctx, reviewID, userID); rerr != nil {
+			return 0, rerr
+		}
+
+		return 0, err
+	}
+
+	c.cache.Delete(review.ReleaseID)
+
+	return likes, nil
+}
+
+// UnlikeReview is LikeReview in reverse. Likes can be taken back from
+// reviews hidden after they were given.
+func (c *Core) UnlikeReview(reviewID uint, userID string) (int64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	review, err := c.repo.GetAnyReviewByID(ctx, reviewID)
+	if err != nil {
+		return 0, err
+	}
+
+	likes, removed, err := c.repo.UnlikeReview(ctx, reviewID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !removed {
+		return likes, nil
+	}
+
+	if err = c.users.DecLike(ctx, review.UserID); err != nil {
+		if _, _, rerr := c.repo.LikeReview(ctx, entity.NewReviewLike(reviewID, userID)); rerr != nil {
+			return 0, rerr
+		}
+
+		return 0, err
+	}
+
+	c.cache.Delete(review.ReleaseID)
+
+	return likes, nil
+}
+
+// ListReviewLikers returns one page of the likes of a review, newest first,
+// and the token of the next page (empty on the last page).
+func (c *Core) ListReviewLikers(reviewID uint, pageSize int, pageToken string) ([]entity.ReviewLike, string, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.ReviewLikeCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeLikesPageToken(pageToken, reviewID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.repo.GetReviewByID(ctx, reviewID); err != nil {
+		return nil, "", err
+	}
+
+	// one extra row tells whether another page exists
+	likes, err := c.repo.ListReviewLikes(ctx, reviewID, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(likes) > pageSize {
+		likes = likes[:pageSize]
+
+		if next, err = encodeLikesPageToken(reviewID, &likes[pageSize-1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return likes, next, nil
+}
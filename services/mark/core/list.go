@@ -0,0 +1,409 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/catalog"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/repository"
+)
+
+var (
+	ErrInvalidListName  = errors.New("list name must be 1 to 100 characters")
+	ErrInvalidListText  = errors.New("list descriptions must be at most 2000 characters and item notes at most 1000")
+	ErrInvalidPosition  = errors.New("position must be positive")
+	ErrListForbidden    = errors.New("list belongs to another user")
+	ErrOwnList          = errors.New("authors cannot like their own lists")
+	ErrListFull         = errors.New("list holds the most items allowed")
+	ErrReleaseListed    = errors.New("release is already in the list")
+	ErrInvalidListOrder = errors.New(`order must be "created_at" or "likes"`)
+)
+
+const (
+	maxListNameLen        = 100
+	maxListDescriptionLen = 2000
+	maxListNoteLen        = 1000
+
+	// MaxListItems is the number of items a list holds at most.
+	MaxListItems = 1000
+)
+
+func validListText(name, description string) error {
+	length := utf8.RuneCountInString(name)
+	if length == 0 || length > maxListNameLen {
+		return ErrInvalidListName
+	}
+
+	if utf8.RuneCountInString(description) > maxListDescriptionLen {
+		return ErrInvalidListText
+	}
+
+	return nil
+}
+
+// visibleList returns the list unless it is private and the viewer is not
+// its author, in which case it does not exist for the viewer.
+func (c *Core) visibleList(ctx context.Context, id uint, viewer string) (*entity.List, error) {
+	list, err := c.repo.GetList(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !list.Public && list.UserID != viewer {
+		return nil, repository.ErrNotFound
+	}
+
+	return list, nil
+}
+
+// ownList returns the list when the user is its author.
+func (c *Core) ownList(ctx context.Context, id uint, userID string) (*entity.List, error) {
+	list, err := c.visibleList(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if list.UserID != userID {
+		return nil, ErrListForbidden
+	}
+
+	return list, nil
+}
+
+func (c *Core) CreateList(userID, name, description string, public bool) (*entity.List, error) {
+	name, description = strings.TrimSpace(name), strings.TrimSpace(description)
+
+	if err := validListText(name, description); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	list := entity.NewList(userID, name, description, public)
+
+	if err := c.repo.CreateList(ctx, list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateList replaces the name, description and visibility of the caller's
+// list.
+func (c *Core) UpdateList(id uint, userID, name, description string, public bool) (*entity.List, error) {
+	name, description = strings.TrimSpace(name), strings.TrimSpace(description)
+
+	if err := validListText(name, description); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	list, err := c.ownList(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if list.Name == name && list.Description == description && list.Public == public {
+		return list, nil
+	}
+
+	now := time.Now()
+
+	list.Name = name
+	list.Description = description
+	list.Public = public
+	list.EditedAt = &now
+
+	if err = c.repo.UpdateList(ctx, list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// DeleteList removes a list on behalf of its author; moderators may pass
+// deleteAny to remove public lists of other users.
+func (c *Core) DeleteList(id uint, userID string, deleteAny bool) error {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	list, err := c.visibleList(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if list.UserID != userID && !deleteAny {
+		return ErrListForbidden
+	}
+
+	return c.repo.DeleteList(ctx, id)
+}
+
+func (c *Core) GetList(id uint, viewer string) (*entity.List, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.visibleList(ctx, id, viewer)
+}
+
+// BrowseLists returns one page of the lists matching the filter. Users
+// browsing their own lists see the private ones too.
+func (c *Core) BrowseLists(filter entity.ListFilter, viewer string, pageSize int, pageToken string) ([]entity.List, string, error) {
+	switch filter.OrderBy {
+	case "":
+		filter.OrderBy = entity.ListOrderCreatedAt
+	case entity.ListOrderCreatedAt, entity.ListOrderLikes:
+	default:
+		return nil, "", ErrInvalidListOrder
+	}
+
+	filter.ReleaseID = strings.TrimSpace(filter.ReleaseID)
+	filter.Private = filter.UserID != "" && filter.UserID == viewer
+
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.ListCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeListsPageToken(pageToken, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	// one extra list tells whether another page exists
+	lists, err := c.repo.ListLists(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(lists) > pageSize {
+		lists = lists[:pageSize]
+
+		if next, err = encodeListsPageToken(filter, &lists[pageSize-1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return lists, next, nil
+}
+
+// AddListItem puts the release in the caller's list at the position, or at
+// the end when position is 0. The release is looked up in the catalog
+// unless it was placed before.
+func (c *Core) AddListItem(listID uint, userID, releaseID, note string, position int) (*entity.ListItem, error) {
+	releaseID, note = strings.TrimSpace(releaseID), strings.TrimSpace(note)
+
+	if len(releaseID) == 0 {
+		return nil, ErrEmptyReleaseID
+	}
+
+	if utf8.RuneCountInString(note) > maxListNoteLen {
+		return nil, ErrInvalidListText
+	}
+
+	if position < 0 {
+		return nil, ErrInvalidPosition
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	list, err := c.ownList(ctx, listID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if list.Items >= MaxListItems {
+		return nil, ErrListFull
+	}
+
+	if err = c.placeRelease(ctx, releaseID); err != nil {
+		if errors.Is(err, catalog.ErrUnknownRelease) {
+			return nil, ErrUnknownRelease
+		}
+
+		return nil, err
+	}
+
+	item := entity.NewListItem(listID, releaseID, note, position)
+
+	if err = c.repo.AddListItem(ctx, item); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExist) {
+			return nil, ErrReleaseListed
+		}
+
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// ownListItem returns the item when the user is the author of its list.
+func (c *Core) ownListItem(ctx context.Context, id uint, userID string) (*entity.ListItem, error) {
+	item, err := c.repo.GetListItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.ownList(ctx, item.ListID, userID); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (c *Core) EditListItem(id uint, userID, note string) (*entity.ListItem, error) {
+	note = strings.TrimSpace(note)
+
+	if utf8.RuneCountInString(note) > maxListNoteLen {
+		return nil, ErrInvalidListText
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	item, err := c.ownListItem(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Note == note {
+		return item, nil
+	}
+
+	item.Note = note
+
+	if err = c.repo.EditListItem(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// MoveListItem puts the item at the position, the end of the list when the
+// position is past it.
+func (c *Core) MoveListItem(id uint, userID string, position int) (*entity.ListItem, error) {
+	if position < 1 {
+		return nil, ErrInvalidPosition
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.ownListItem(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return c.repo.MoveListItem(ctx, id, position)
+}
+
+func (c *Core) RemoveListItem(id uint, userID string) error {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	item, err := c.ownListItem(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.repo.RemoveListItem(ctx, item)
+}
+
+// GetListItems returns one page of the items of a list in order and the
+// token of the next page (empty on the last page).
+func (c *Core) GetListItems(listID uint, viewer string, pageSize int, pageToken string) ([]entity.ListItem, string, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.ListItemCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeListItemsPageToken(pageToken, listID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.visibleList(ctx, listID, viewer); err != nil {
+		return nil, "", err
+	}
+
+	// one extra item tells whether another page exists
+	items, err := c.repo.ListListItems(ctx, listID, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(items) > pageSize {
+		items = items[:pageSize]
+
+		if next, err = encodeListItemsPageToken(listID, &items[pageSize-1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return items, next, nil
+}
+
+// LikeList likes the list on behalf of the user and returns its like
+// count. Liking a list twice changes nothing.
+func (c *Core) LikeList(listID uint, userID string) (int64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	list, err := c.visibleList(ctx, listID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if list.UserID == userID {
+		return 0, ErrOwnList
+	}
+
+	likes, _, err := c.repo.LikeList(ctx, entity.NewListLike(listID, userID))
+
+	return likes, err
+}
+
+// UnlikeList is LikeList in reverse.
+func (c *Core) UnlikeList(listID uint, userID string) (int64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.visibleList(ctx, listID, userID); err != nil {
+		return 0, err
+	}
+
+	likes, _, err := c.repo.UnlikeList(ctx, listID, userID)
+
+	return likes, err
+}
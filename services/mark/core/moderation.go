@@ -0,0 +1,161 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/repository"
+)
+
+var (
+	ErrInvalidReason   = errors.New(`reason must be "spam", "abuse", "off_topic", "spoiler" or "other"`)
+	ErrInvalidDetails  = errors.New("details must be at most 1000 characters")
+	ErrReportOwnReview = errors.New("authors cannot report their own reviews")
+	ErrAlreadyReported = errors.New("review is already reported by the user")
+	ErrReviewHidden    = errors.New("review is hidden by moderators")
+)
+
+const maxReportDetailsLen = 1000
+
+// ReportReview reports the review on behalf of the user. Once reports by
+// autoHideReports distinct users are open the review is hidden until a
+// moderator looks at it; its reports stay in the queue.
+func (c *Core) ReportReview(reviewID uint, userID string, reason entity.ReportReason, details string) error {
+	if !reason.Valid() {
+		return ErrInvalidReason
+	}
+
+	if utf8.RuneCountInString(details) > maxReportDetailsLen {
+		return ErrInvalidDetails
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	review, err := c.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+
+	if review.UserID == userID {
+		return ErrReportOwnReview
+	}
+
+	open, err := c.repo.CreateReport(ctx, entity.NewReviewReport(reviewID, userID, reason, details))
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExist) {
+			return ErrAlreadyReported
+		}
+
+		return err
+	}
+
+	if c.autoHideReports > 0 && open >= int64(c.autoHideReports) {
+		note := fmt.Sprintf("reported by %d users", open)
+
+		// the report is stored, the next one hides the review if this fails
+		_, _ = c.hide(ctx, entity.NewModerationLog(reviewID, "", entity.ModerationActionHide, note), false)
+	}
+
+	return nil
+}
+
+// hide hides the review and takes its score out of the mark of its release.
+func (c *Core) hide(ctx context.Context, entry *entity.ModerationLog, resolve bool) (*entity.Review, error) {
+	review, changed, err := c.repo.HideReview(ctx, entry, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		c.cache.Delete(review.ReleaseID)
+
+		c.recounter.Rescore(review.ReleaseID, &review.Count, nil)
+	}
+
+	return review, nil
+}
+
+// HideReview hides the review on behalf of a moderator and closes its open
+// reports. Hiding a hidden review only closes them.
+func (c *Core) HideReview(reviewID uint, moderatorID, note string) (*entity.Review, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.hide(ctx, entity.NewModerationLog(reviewID, moderatorID, entity.ModerationActionHide, note), true)
+}
+
+// RestoreReview is HideReview in reverse.
+func (c *Core) RestoreReview(reviewID uint, moderatorID, note string) (*entity.Review, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	entry := entity.NewModerationLog(reviewID, moderatorID, entity.ModerationActionRestore, note)
+
+	review, changed, err := c.repo.RestoreReview(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		c.cache.Delete(review.ReleaseID)
+
+		c.recounter.Rescore(review.ReleaseID, nil, &review.Count)
+	}
+
+	return review, nil
+}
+
+// ResolveReports closes the open reports of the review, hidden or not,
+// and returns how many it closed.
+func (c *Core) ResolveReports(reviewID uint, moderatorID, note string) (int64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.repo.ResolveReports(ctx, entity.NewModerationLog(reviewID, moderatorID, entity.ModerationActionResolve, note))
+}
+
+// ListModerationQueue returns one page of the reviews with open reports and
+// the token of the next page (empty on the last page).
+func (c *Core) ListModerationQueue(pageSize int, pageToken string) ([]entity.ModerationItem, string, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.ModerationCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeModerationPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	// one extra item tells whether another page exists
+	items, err := c.repo.ListModerationQueue(ctx, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(items) > pageSize {
+		items = items[:pageSize]
+
+		if next, err = encodeModerationPageToken(&items[pageSize-1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return items, next, nil
+}
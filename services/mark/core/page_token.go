@@ -0,0 +1,346 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+)
+
+// likesPageToken is handed out base64 encoded. It carries the review it was
+// issued for so that a token cannot be replayed against another review.
+type likesPageToken struct {
+	ReviewID uint                    `json:"r"`
+	After    entity.ReviewLikeCursor `json:"a"`
+}
+
+func encodeLikesPageToken(reviewID uint, last *entity.ReviewLike) (string, error) {
+	raw, err := json.Marshal(likesPageToken{
+		ReviewID: reviewID,
+		After: entity.ReviewLikeCursor{
+			CreatedAt: last.CreatedAt,
+			UserID:    last.UserID,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeLikesPageToken(token string, reviewID uint) (*entity.ReviewLikeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed likesPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.ReviewID != reviewID {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// commentsPageToken is likesPageToken for comment listings.
+type commentsPageToken struct {
+	Filter entity.CommentFilter `json:"f"`
+	After  entity.CommentCursor `json:"a"`
+}
+
+func encodeCommentsPageToken(filter entity.CommentFilter, last *entity.Comment) (string, error) {
+	raw, err := json.Marshal(commentsPageToken{
+		Filter: filter,
+		After: entity.CommentCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeCommentsPageToken(token string, filter entity.CommentFilter) (*entity.CommentCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed commentsPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// marksPageToken is likesPageToken for mark listings.
+type marksPageToken struct {
+	Filter entity.MarkFilter `json:"f"`
+	After  entity.MarkCursor `json:"a"`
+}
+
+func encodeMarksPageToken(filter entity.MarkFilter, last *entity.Mark) (string, error) {
+	raw, err := json.Marshal(marksPageToken{
+		Filter: filter,
+		After:  *entity.NewMarkCursor(last),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeMarksPageToken(token string, filter entity.MarkFilter) (*entity.MarkCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed marksPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// reviewsPageToken is likesPageToken for review listings.
+type reviewsPageToken struct {
+	Filter entity.ReviewFilter `json:"f"`
+	After  entity.ReviewCursor `json:"a"`
+}
+
+func encodeReviewsPageToken(filter entity.ReviewFilter, last *entity.Review) (string, error) {
+	raw, err := json.Marshal(reviewsPageToken{
+		Filter: filter,
+		After:  *entity.NewReviewCursor(last),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeReviewsPageToken(token string, filter entity.ReviewFilter) (*entity.ReviewCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed reviewsPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// moderationPageToken is the cursor of the moderation queue, which has no
+// filter to carry.
+type moderationPageToken struct {
+	After entity.ModerationCursor `json:"a"`
+}
+
+func encodeModerationPageToken(last *entity.ModerationItem) (string, error) {
+	raw, err := json.Marshal(moderationPageToken{
+		After: entity.ModerationCursor{
+			FirstReportID: last.FirstReportID,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeModerationPageToken(token string) (*entity.ModerationCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed moderationPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// chartPageToken is likesPageToken for charts.
+type chartPageToken struct {
+	Chart  entity.Chart       `json:"c"`
+	Filter entity.ChartFilter `json:"f"`
+	After  entity.ChartCursor `json:"a"`
+}
+
+func encodeChartPageToken(chart entity.Chart, filter entity.ChartFilter, last *entity.ChartEntry) (string, error) {
+	raw, err := json.Marshal(chartPageToken{
+		Chart:  chart,
+		Filter: filter,
+		After: entity.ChartCursor{
+			Rank:     last.Rank,
+			Position: last.Position,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeChartPageToken(token string, chart entity.Chart, filter entity.ChartFilter) (*entity.ChartCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed chartPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Chart != chart || parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// diaryPageToken is likesPageToken for diaries.
+type diaryPageToken struct {
+	Filter entity.DiaryFilter `json:"f"`
+	After  entity.DiaryCursor `json:"a"`
+}
+
+func encodeDiaryPageToken(filter entity.DiaryFilter, last *entity.DiaryEntry) (string, error) {
+	raw, err := json.Marshal(diaryPageToken{
+		Filter: filter,
+		After: entity.DiaryCursor{
+			ListenedOn: last.ListenedOn,
+			ID:         last.ID,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeDiaryPageToken(token string, filter entity.DiaryFilter) (*entity.DiaryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed diaryPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// listsPageToken is likesPageToken for browsing lists.
+type listsPageToken struct {
+	Filter entity.ListFilter `json:"f"`
+	After  entity.ListCursor `json:"a"`
+}
+
+func encodeListsPageToken(filter entity.ListFilter, last *entity.List) (string, error) {
+	raw, err := json.Marshal(listsPageToken{
+		Filter: filter,
+		After:  *entity.NewListCursor(last),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeListsPageToken(token string, filter entity.ListFilter) (*entity.ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed listsPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// listItemsPageToken is likesPageToken for the items of a list.
+type listItemsPageToken struct {
+	ListID uint                  `json:"l"`
+	After  entity.ListItemCursor `json:"a"`
+}
+
+func encodeListItemsPageToken(listID uint, last *entity.ListItem) (string, error) {
+	raw, err := json.Marshal(listItemsPageToken{
+		ListID: listID,
+		After: entity.ListItemCursor{
+			Position: last.Position,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeListItemsPageToken(token string, listID uint) (*entity.ListItemCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed listItemsPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.ListID != listID {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
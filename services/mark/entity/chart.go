@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+)
+
+type ChartPeriod string
+
+const (
+	ChartAllTime ChartPeriod = "all_time"
+	ChartYear    ChartPeriod = "year"
+	ChartDecade  ChartPeriod = "decade"
+	ChartWeek    ChartPeriod = "week"
+	ChartMonth   ChartPeriod = "month"
+)
+
+// ChartWindows are the rolling windows of review activity that trending
+// charts rank releases by; the other charts rank the marks of releases by
+// the year they came out.
+var ChartWindows = map[ChartPeriod]time.Duration{
+	ChartWeek:  7 * 24 * time.Hour,
+	ChartMonth: 30 * 24 * time.Hour,
+}
+
+// Chart names one precomputed ranking. Year is the year of a year chart,
+// the first year of a decade chart and 0 otherwise.
+type Chart struct {
+	Period ChartPeriod `json:"p"`
+	Year   int         `json:"y,omitempty"`
+}
+
+func (c Chart) Key() string {
+	if c.Period == ChartYear || c.Period == ChartDecade {
+		return string(c.Period) + ":" + strconv.Itoa(c.Year)
+	}
+
+	return string(c.Period)
+}
+
+// ChartEntry is a release ranked in a chart by its weighted mark, computed
+// from the reviews of the window for trending charts. Position is its place
+// in a filtered listing, which equals Rank when nothing is filtered out.
+type ChartEntry struct {
+	Chart     string    `gorm:"primaryKey;size:32" json:"-"`
+	Rank      int       `gorm:"primaryKey" json:"rank"`
+	Position  int       `gorm:"-" json:"position"`
+	ReleaseID string    `gorm:"index" json:"release_id"`
+	Weighted  float32   `json:"weighted"`
+	Value     float32   `json:"value"`
+	Reviews   int       `json:"reviews"`
+	CreatedAt time.Time `json:"-"`
+}
+
+func (e *ChartEntry) ToPB() *pb.ChartEntry {
+	return &pb.ChartEntry{
+		Rank:      int32(e.Rank),
+		Position:  int32(e.Position),
+		ReleaseId: e.ReleaseID,
+		Weighted:  e.Weighted,
+		Value:     e.Value,
+		Reviews:   int32(e.Reviews),
+	}
+}
+
+// ChartFilter narrows a chart down by the catalog metadata of releases.
+type ChartFilter struct {
+	Genre       string `json:"g,omitempty"`
+	Country     string `json:"c,omitempty"`
+	PrimaryType string `json:"t,omitempty"`
+}
+
+// ChartCursor is the rank and position of the last entry on a page.
+type ChartCursor struct {
+	Rank     int `json:"r"`
+	Position int `json:"p"`
+}
+
+// ChartPage is one page of a chart. ComputedAt is when the chart was
+// last rebuilt, zero for an empty page.
+type ChartPage struct {
+	Entries       []ChartEntry
+	ComputedAt    time.Time
+	NextPageToken string
+}
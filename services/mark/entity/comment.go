@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Comment is a comment on a review or, when ParentID is set, a reply to
+// another comment. Depth is 0 for comments on the review itself. A deleted
+// comment that still has replies is kept without its text so that the
+// thread stays intact.
+type Comment struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	ReviewID  uint       `gorm:"index;not null" json:"review_id"`
+	ParentID  *uint      `gorm:"index" json:"parent_id,omitempty"`
+	UserID    string     `json:"user_id,omitempty"`
+	Text      string     `json:"text"`
+	Depth     int        `json:"depth"`
+	Replies   int        `gorm:"not null;default:0" json:"replies"`
+	Deleted   bool       `gorm:"not null;default:false" json:"deleted"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+}
+
+func NewComment(reviewID uint, parent *Comment, userID, text string) *Comment {
+	comment := &Comment{
+		ReviewID: reviewID,
+		UserID:   userID,
+		Text:     text,
+	}
+
+	if parent != nil {
+		comment.ParentID = &parent.ID
+		comment.Depth = parent.Depth + 1
+	}
+
+	return comment
+}
+
+func (c *Comment) ToPB() *pb.Comment {
+	comment := &pb.Comment{
+		Id:        uint64(c.ID),
+		ReviewId:  uint64(c.ReviewID),
+		UserId:    c.UserID,
+		Text:      c.Text,
+		Depth:     int32(c.Depth),
+		Replies:   int32(c.Replies),
+		Deleted:   c.Deleted,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+
+	if c.ParentID != nil {
+		comment.ParentId = uint64(*c.ParentID)
+	}
+
+	if c.EditedAt != nil {
+		comment.EditedAt = timestamppb.New(*c.EditedAt)
+	}
+
+	return comment
+}
+
+// CommentFilter selects the comments on a review (ParentID 0) or the
+// replies to one comment.
+type CommentFilter struct {
+	ReviewID uint `json:"r"`
+	ParentID uint `json:"p,omitempty"`
+}
+
+// CommentCursor is the sort key of the last comment on a page.
+type CommentCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uint      `json:"i"`
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DateLayout is how diary dates are written, "2025-03-14".
+const DateLayout = time.DateOnly
+
+// DiaryEntry logs that a user listened to a release on a day. Users may log
+// a release any number of times; Relisten marks the listens after the first.
+// Score rates that listen only and does not count towards marks, which
+// come from reviews.
+type DiaryEntry struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	UserID     string     `gorm:"index:idx_diary_entries_user_listened;not null" json:"user_id"`
+	ReleaseID  string     `gorm:"index;not null" json:"release_id"`
+	ListenedOn time.Time  `gorm:"index:idx_diary_entries_user_listened;not null" json:"listened_on"`
+	Score      *int       `json:"score,omitempty"`
+	ReviewID   *uint      `gorm:"index" json:"review_id,omitempty"`
+	Relisten   bool       `gorm:"not null;default:false" json:"relisten"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	EditedAt   *time.Time `json:"edited_at,omitempty"`
+}
+
+func NewDiaryEntry(userID, releaseID string, listenedOn time.Time, score *int, reviewID *uint, relisten bool) *DiaryEntry {
+	return &DiaryEntry{
+		UserID:     userID,
+		ReleaseID:  releaseID,
+		ListenedOn: listenedOn,
+		Score:      score,
+		ReviewID:   reviewID,
+		Relisten:   relisten,
+	}
+}
+
+func (e *DiaryEntry) ToPB() *pb.DiaryEntry {
+	entry := &pb.DiaryEntry{
+		Id:         uint64(e.ID),
+		UserId:     e.UserID,
+		ReleaseId:  e.ReleaseID,
+		ListenedOn: e.ListenedOn.Format(DateLayout),
+		Relisten:   e.Relisten,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+
+	if e.Score != nil {
+		score := int32(*e.Score)
+		entry.Score = &score
+	}
+
+	if e.ReviewID != nil {
+		entry.ReviewId = uint64(*e.ReviewID)
+	}
+
+	if e.EditedAt != nil {
+		entry.EditedAt = timestamppb.New(*e.EditedAt)
+	}
+
+	return entry
+}
+
+// DiaryFilter selects the entries of a user's diary in a year, or in one
+// month of it when Month is not 0.
+type DiaryFilter struct {
+	UserID string `json:"u"`
+	Year   int    `json:"y"`
+	Month  int    `json:"m,omitempty"`
+}
+
+// Span returns the first day of the selected period and the day after it.
+func (f DiaryFilter) Span() (time.Time, time.Time) {
+	if f.Month == 0 {
+		from := time.Date(f.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		return from, from.AddDate(1, 0, 0)
+	}
+
+	from := time.Date(f.Year, time.Month(f.Month), 1, 0, 0, 0, 0, time.UTC)
+
+	return from, from.AddDate(0, 1, 0)
+}
+
+// DiaryCursor is the sort key of the last entry on a page.
+type DiaryCursor struct {
+	ListenedOn time.Time `json:"l"`
+	ID         uint      `json:"i"`
+}
+
+// ArtistListens counts the diary entries of one artist.
+type ArtistListens struct {
+	ArtistID string `json:"artist_id"`
+	Entries  int64  `json:"entries"`
+}
+
+// DiaryStats sums up a user's diary for a year. AverageScore is nil when no
+// entry of the year is scored; entries of releases not yet placed in the
+// catalog are missing from TopArtists.
+type DiaryStats struct {
+	Year         int             `json:"year"`
+	Entries      int64           `json:"entries"`
+	Releases     int64           `json:"releases"`
+	Relistens    int64           `json:"relistens"`
+	AverageScore *float64        `json:"average_score,omitempty"`
+	TopArtists   []ArtistListens `json:"top_artists"`
+}
+
+func (s *DiaryStats) ToPB() *pb.DiaryStats {
+	stats := &pb.DiaryStats{
+		Year:       int32(s.Year),
+		Entries:    s.Entries,
+		Releases:   s.Releases,
+		Relistens:  s.Relistens,
+		TopArtists: make([]*pb.ArtistListens, len(s.TopArtists)),
+	}
+
+	if s.AverageScore != nil {
+		average := float32(*s.AverageScore)
+		stats.AverageScore = &average
+	}
+
+	for i, artist := range s.TopArtists {
+		stats.TopArtists[i] = &pb.ArtistListens{
+			ArtistId: artist.ArtistID,
+			Entries:  artist.Entries,
+		}
+	}
+
+	return stats
+}
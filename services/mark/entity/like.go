@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReviewLike records that a user likes a review; a user likes a review at
+// most once.
+type ReviewLike struct {
+	ReviewID  uint      `gorm:"primaryKey" json:"review_id"`
+	UserID    string    `gorm:"primaryKey" json:"user_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func NewReviewLike(reviewID uint, userID string) *ReviewLike {
+	return &ReviewLike{
+		ReviewID: reviewID,
+		UserID:   userID,
+	}
+}
+
+func (l *ReviewLike) ToPB() *pb.ReviewLike {
+	return &pb.ReviewLike{
+		ReviewId:  uint64(l.ReviewID),
+		UserId:    l.UserID,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+	}
+}
+
+// ReviewLikeCursor is the sort key of the last like on a page.
+type ReviewLikeCursor struct {
+	CreatedAt time.Time `json:"c"`
+	UserID    string    `json:"u"`
+}
@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// List is a named, ordered selection of releases curated by a user.
+// Private lists are seen by their author only. Items and Likes count the
+// rows of the list kept in ListItem and ListLike.
+type List struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	UserID      string     `gorm:"index;not null" json:"user_id"`
+	Name        string     `gorm:"not null" json:"name"`
+	Description string     `json:"description,omitempty"`
+	Public      bool       `gorm:"index;not null;default:false" json:"public"`
+	Items       int        `gorm:"not null;default:0" json:"items"`
+	Likes       int64      `gorm:"not null;default:0" json:"likes"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	EditedAt    *time.Time `json:"edited_at,omitempty"`
+}
+
+func NewList(userID, name, description string, public bool) *List {
+	return &List{
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+		Public:      public,
+	}
+}
+
+func (l *List) ToPB() *pb.List {
+	list := &pb.List{
+		Id:          uint64(l.ID),
+		UserId:      l.UserID,
+		Name:        l.Name,
+		Description: l.Description,
+		Public:      l.Public,
+		Items:       int32(l.Items),
+		Likes:       l.Likes,
+		CreatedAt:   timestamppb.New(l.CreatedAt),
+	}
+
+	if l.EditedAt != nil {
+		list.EditedAt = timestamppb.New(*l.EditedAt)
+	}
+
+	return list
+}
+
+// ListItem places a release in a list; a release is in a list at most
+// once. Positions run from 1 to the item count of the list without gaps.
+type ListItem struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ListID    uint      `gorm:"uniqueIndex:idx_list_items_list_release;index:idx_list_items_list_position;not null" json:"list_id"`
+	ReleaseID string    `gorm:"uniqueIndex:idx_list_items_list_release;not null" json:"release_id"`
+	Position  int       `gorm:"index:idx_list_items_list_position;not null" json:"position"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func NewListItem(listID uint, releaseID, note string, position int) *ListItem {
+	return &ListItem{
+		ListID:    listID,
+		ReleaseID: releaseID,
+		Position:  position,
+		Note:      note,
+	}
+}
+
+func (i *ListItem) ToPB() *pb.ListItem {
+	return &pb.ListItem{
+		Id:        uint64(i.ID),
+		ListId:    uint64(i.ListID),
+		ReleaseId: i.ReleaseID,
+		Position:  int32(i.Position),
+		Note:      i.Note,
+		CreatedAt: timestamppb.New(i.CreatedAt),
+	}
+}
+
+// ListItemCursor is the position of the last item on a page.
+type ListItemCursor struct {
+	Position int `json:"p"`
+}
+
+// ListLike records that a user likes a list; a user likes a list at most
+// once.
+type ListLike struct {
+	ListID    uint      `gorm:"primaryKey" json:"list_id"`
+	UserID    string    `gorm:"primaryKey" json:"user_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func NewListLike(listID uint, userID string) *ListLike {
+	return &ListLike{
+		ListID: listID,
+		UserID: userID,
+	}
+}
+
+type ListOrder string
+
+const (
+	ListOrderCreatedAt ListOrder = "created_at"
+	ListOrderLikes     ListOrder = "likes"
+)
+
+// ListFilter selects the lists to browse: the public lists of everybody,
+// or of one user when UserID is set, optionally only those holding a
+// release. Private adds the private lists of the user; it is only set for
+// the user's own lists. Lists are sorted by OrderBy, highest first.
+type ListFilter struct {
+	UserID    string    `json:"u,omitempty"`
+	ReleaseID string    `json:"r,omitempty"`
+	Private   bool      `json:"p,omitempty"`
+	OrderBy   ListOrder `json:"o"`
+}
+
+// ListCursor is the sort key of the last list on a page.
+type ListCursor struct {
+	CreatedAt time.Time `json:"c"`
+	Likes     int64     `json:"l"`
+	ID        uint      `json:"i"`
+}
+
+func NewListCursor(l *List) *ListCursor {
+	return &ListCursor{
+		CreatedAt: l.CreatedAt,
+		Likes:     l.Likes,
+		ID:        l.ID,
+	}
+}
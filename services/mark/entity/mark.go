@@ -1,33 +1,142 @@
 package entity
 
 import (
+	"math"
 	"time"
 
 	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
 )
 
+// Reviews score releases with whole numbers from MinScore to MaxScore.
+const (
+	MinScore = 0
+	MaxScore = 10
+)
+
+// Histogram counts the reviews of a release by score: Histogram[s] is the
+// number of reviews scoring s.
+type Histogram [MaxScore - MinScore + 1]int64
+
+// Move records a review changing its score in a histogram of score
+// changes: removed is the score it no longer has, added the one it has now,
+// either nil when the review is created or deleted.
+func (h *Histogram) Move(removed, added *int) {
+	if removed != nil {
+		h[clampScore(*removed)-MinScore]--
+	}
+
+	if added != nil {
+		h[clampScore(*added)-MinScore]++
+	}
+}
+
+// Add counts n reviews scoring score.
+func (h *Histogram) Add(score int, n int64) {
+	h[clampScore(score)-MinScore] += n
+}
+
+// Mark sums up the reviews of a release. The histogram is the source of the
+// other statistics, which are kept alongside it for sorting. Weighted is the
+// mean pulled towards the mean of all reviews until the release has enough
+// reviews of its own, see Weigh.
 type Mark struct {
-	ID        uint `gorm:"primaryKey" json:"id"`
-	ReleaseID string
-	Value     float32
-	Reviews   int
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ReleaseID string    `gorm:"uniqueIndex" json:"release_id"`
+	Value     float32   `json:"value"`
+	Reviews   int       `json:"reviews"`
+	Median    float32   `json:"median"`
+	StdDev    float32   `json:"std_dev"`
+	Histogram Histogram `gorm:"serializer:json" json:"histogram"`
+	Weighted  float32   `gorm:"index" json:"weighted"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"-"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
 }
 
-func NewMark(releaeID string, value float32) *Mark {
+func NewMark(releaeID string) *Mark {
 	return &Mark{
 		ReleaseID: releaeID,
-		Value:     value,
 		CreatedAt: time.Now(),
 	}
 }
 
+// Apply adds a histogram of score changes made with Move to the mark.
+func (m *Mark) Apply(delta Histogram) {
+	for bucket, n := range delta {
+		m.Histogram[bucket] = max(m.Histogram[bucket]+n, 0)
+	}
+
+	m.refresh()
+}
+
+func clampScore(score int) int {
+	return min(max(score, MinScore), MaxScore)
+}
+
+// refresh derives the statistics from the histogram.
+func (m *Mark) refresh() {
+	var count, sum, squares int64
+
+	for bucket, n := range m.Histogram {
+		score := int64(bucket + MinScore)
+
+		count += n
+		sum += n * score
+		squares += n * score * score
+	}
+
+	m.Reviews = int(count)
+
+	if count == 0 {
+		m.Value, m.Median, m.StdDev = 0, 0, 0
+
+		return
+	}
+
+	mean := float64(sum) / float64(count)
+	variance := float64(squares)/float64(count) - mean*mean
+
+	m.Value = float32(mean)
+	m.StdDev = float32(math.Sqrt(max(variance, 0)))
+	m.Median = float32(float64(m.nth((count-1)/2)+m.nth(count/2)) / 2)
+}
+
+// nth returns the score of the n-th review in ascending order, from 0.
+func (m *Mark) nth(n int64) int {
+	for bucket, count := range m.Histogram {
+		if n < count {
+			return bucket + MinScore
+		}
+
+		n -= count
+	}
+
+	return MaxScore
+}
+
+// Weigh sets the Bayesian average of the mark: its reviews count together
+// with minVotes imaginary reviews scoring the global mean, so a single 10
+// does not outrank hundreds of 9s. Releases without reviews weigh 0.
+func (m *Mark) Weigh(mean float64, minVotes int) {
+	if m.Reviews == 0 {
+		m.Weighted = 0
+
+		return
+	}
+
+	votes := float64(m.Reviews + minVotes)
+
+	m.Weighted = float32((float64(m.Reviews)*float64(m.Value) + float64(minVotes)*mean) / votes)
+}
+
 func (m *Mark) ToPB() *pb.Mark {
 	return &pb.Mark{
 		Id:        uint64(m.ID),
 		ReleaseId: m.ReleaseID,
 		Value:     m.Value,
 		Reviews:   int32(m.Reviews),
+		Median:    m.Median,
+		StdDev:    m.StdDev,
+		Histogram: m.Histogram[:],
+		Weighted:  m.Weighted,
 	}
 }
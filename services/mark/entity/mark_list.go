@@ -0,0 +1,33 @@
+package entity
+
+type MarkOrder string
+
+const (
+	MarkOrderWeighted MarkOrder = "weighted"
+	MarkOrderValue    MarkOrder = "value"
+	MarkOrderReviews  MarkOrder = "reviews"
+)
+
+// MarkFilter selects and orders marks for ListMarks.
+type MarkFilter struct {
+	MinReviews int       `json:"m,omitempty"`
+	OrderBy    MarkOrder `json:"o"`
+	Desc       bool      `json:"d,omitempty"`
+}
+
+// MarkCursor is the sort key of the last mark on a page.
+type MarkCursor struct {
+	Weighted float32 `json:"w"`
+	Value    float32 `json:"v"`
+	Reviews  int     `json:"r"`
+	ID       uint    `json:"i"`
+}
+
+func NewMarkCursor(m *Mark) *MarkCursor {
+	return &MarkCursor{
+		Weighted: m.Weighted,
+		Value:    m.Value,
+		Reviews:  m.Reviews,
+		ID:       m.ID,
+	}
+}
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReportReason string
+
+const (
+	ReportReasonSpam     ReportReason = "spam"
+	ReportReasonAbuse    ReportReason = "abuse"
+	ReportReasonOffTopic ReportReason = "off_topic"
+	ReportReasonSpoiler  ReportReason = "spoiler"
+	ReportReasonOther    ReportReason = "other"
+)
+
+func (r ReportReason) Valid() bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonAbuse, ReportReasonOffTopic, ReportReasonSpoiler, ReportReasonOther:
+		return true
+	}
+
+	return false
+}
+
+// ReviewReport flags a review for moderators; a user reports a review at
+// most once. Reports stay open until a moderator acts on the review.
+type ReviewReport struct {
+	ID         uint         `gorm:"primaryKey" json:"id"`
+	ReviewID   uint         `gorm:"uniqueIndex:idx_review_reports_review_user;not null" json:"review_id"`
+	UserID     string       `gorm:"uniqueIndex:idx_review_reports_review_user;not null" json:"user_id"`
+	Reason     ReportReason `gorm:"size:16;not null" json:"reason"`
+	Details    string       `json:"details,omitempty"`
+	CreatedAt  time.Time    `gorm:"autoCreateTime" json:"created_at"`
+	ResolvedAt *time.Time   `gorm:"index" json:"resolved_at,omitempty"`
+}
+
+func NewReviewReport(reviewID uint, userID string, reason ReportReason, details string) *ReviewReport {
+	return &ReviewReport{
+		ReviewID: reviewID,
+		UserID:   userID,
+		Reason:   reason,
+		Details:  details,
+	}
+}
+
+type ModerationAction string
+
+const (
+	ModerationActionHide    ModerationAction = "hide"
+	ModerationActionRestore ModerationAction = "restore"
+	ModerationActionResolve ModerationAction = "resolve"
+)
+
+// ModerationLog records what was done to a review and by whom, an empty
+// ModeratorID standing for the automatic hiding of reported reviews.
+type ModerationLog struct {
+	ID          uint             `gorm:"primaryKey" json:"id"`
+	ReviewID    uint             `gorm:"index;not null" json:"review_id"`
+	ModeratorID string           `json:"moderator_id,omitempty"`
+	Action      ModerationAction `gorm:"size:16;not null" json:"action"`
+	Note        string           `json:"note,omitempty"`
+	CreatedAt   time.Time        `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func NewModerationLog(reviewID uint, moderatorID string, action ModerationAction, note string) *ModerationLog {
+	return &ModerationLog{
+		ReviewID:    reviewID,
+		ModeratorID: moderatorID,
+		Action:      action,
+		Note:        note,
+	}
+}
+
+// ModerationItem is a review in the moderation queue with its open reports
+// counted by reason. FirstReportID orders the queue, oldest first.
+type ModerationItem struct {
+	Review        Review                 `json:"review"`
+	Reports       int64                  `json:"reports"`
+	Reasons       map[ReportReason]int64 `json:"reasons"`
+	FirstReportID uint                   `json:"-"`
+	FirstReported time.Time              `json:"first_reported_at"`
+}
+
+func (i *ModerationItem) ToPB() *pb.ModerationItem {
+	reasons := make(map[string]int64, len(i.Reasons))
+	for reason, count := range i.Reasons {
+		reasons[string(reason)] = count
+	}
+
+	return &pb.ModerationItem{
+		Review:          i.Review.ToPB(),
+		Reports:         i.Reports,
+		Reasons:         reasons,
+		FirstReportedAt: timestamppb.New(i.FirstReported),
+	}
+}
+
+// ModerationCursor is the sort key of the last item on a page.
+type ModerationCursor struct {
+	FirstReportID uint `json:"r"`
+}
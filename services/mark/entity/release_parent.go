@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReleaseParent places a reviewed release in the catalog of the music
+// service so that its reviews count towards the marks of its release group
+// and artist. It also keeps the metadata charts are filtered by; Described
+// is false for releases placed before that metadata was kept.
+type ReleaseParent struct {
+	ReleaseID      string    `gorm:"primaryKey" json:"release_id"`
+	ReleaseGroupID string    `gorm:"index" json:"release_group_id"`
+	ArtistID       string    `gorm:"index" json:"artist_id"`
+	Year           int       `gorm:"index;not null;default:0" json:"year,omitempty"`
+	Country        string    `gorm:"size:2;index;not null;default:''" json:"country,omitempty"`
+	PrimaryType    string    `gorm:"index;not null;default:''" json:"primary_type,omitempty"`
+	Genres         []string  `gorm:"-" json:"genres,omitempty"`
+	Described      bool      `gorm:"not null;default:false" json:"-"`
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"-"`
+}
+
+// ReleaseGenre tags a placed release with a genre of its release group.
+type ReleaseGenre struct {
+	ReleaseID string `gorm:"primaryKey" json:"release_id"`
+	Genre     string `gorm:"primaryKey;index" json:"genre"`
+}
+
+// ParseYear takes the year from a "2025-03-14", "2025-03" or "2025" date
+// and returns 0 when there is none.
+func ParseYear(date string) int {
+	if len(date) < 4 {
+		return 0
+	}
+
+	year, err := strconv.Atoi(date[:4])
+	if err != nil || year <= 0 {
+		return 0
+	}
+
+	return year
+}
+
+// NormalizeTag lowercases a genre or release type so that filters match
+// regardless of how the catalog spells them.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
@@ -1,37 +1,113 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Review is the one review a user keeps per release; edits replace it and
+// leave the previous text and score behind as a ReviewRevision.
 type Review struct {
-	ID        uint      `gorm:"primarKey" json:"id"`
-	Text      string    `json:"text"`
-	Count     int       `json:"count"`
-	UserID    string    `json:"user_id"`
-	Likes     int64     `json:"likes"`
-	ReleaseID string    `json:"release_id"`
-	CreatedAt time.Time `json:"-"`
+	ID        uint       `gorm:"primarKey" json:"id"`
+	Text      string     `json:"text"`
+	Count     int        `json:"count"`
+	UserID    string     `gorm:"uniqueIndex:idx_reviews_user_release" json:"user_id"`
+	Likes     int64      `json:"likes"`
+	Comments  int        `gorm:"not null;default:0" json:"comments"`
+	ReleaseID string     `gorm:"uniqueIndex:idx_reviews_user_release;index:idx_reviews_release_created" json:"release_id"`
+	Language  string     `gorm:"size:8;index;not null;default:''" json:"language,omitempty"`
+	CreatedAt time.Time  `gorm:"index:idx_reviews_release_created" json:"-"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+	// HiddenAt is set while moderators keep the review out of marks and
+	// listings; it is kept for audit rather than deleted.
+	HiddenAt *time.Time `gorm:"index" json:"hidden_at,omitempty"`
 }
 
-func NewReview(releaeID, text, userID string, count int) *Review {
+func NewReview(releaeID, text, userID, language string, count int) *Review {
 	return &Review{
 		Text:      text,
 		ReleaseID: releaeID,
 		UserID:    userID,
+		Language:  language,
 		Count:     count,
 		CreatedAt: time.Now(),
 	}
 }
 
+// ScoreOnly tells a quick rating from a review with text.
+func (r *Review) ScoreOnly() bool {
+	return strings.TrimSpace(r.Text) == ""
+}
+
+// ReviewKind selects reviews by whether they have text.
+type ReviewKind string
+
+const (
+	ReviewKindText  ReviewKind = "text"
+	ReviewKindScore ReviewKind = "score"
+	ReviewKindAll   ReviewKind = "all"
+)
+
 func (r *Review) ToPB() *pb.Review {
-	return &pb.Review{
+	review := &pb.Review{
 		Id:        uint64(r.ID),
 		Text:      r.Text,
 		Count:     int32(r.Count),
 		UserId:    r.UserID,
 		ReleaseId: r.ReleaseID,
+		Likes:     r.Likes,
+		Comments:  int32(r.Comments),
+		Language:  r.Language,
+	}
+
+	if r.EditedAt != nil {
+		review.EditedAt = timestamppb.New(*r.EditedAt)
+	}
+
+	if r.HiddenAt != nil {
+		review.HiddenAt = timestamppb.New(*r.HiddenAt)
+	}
+
+	return review
+}
+
+// ReviewRevision is an earlier version of a review. WrittenAt is when that
+// version was posted, CreatedAt when it was replaced.
+type ReviewRevision struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ReviewID  uint      `gorm:"index;not null" json:"review_id"`
+	Text      string    `json:"text"`
+	Count     int       `json:"count"`
+	WrittenAt time.Time `json:"written_at"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"replaced_at"`
+}
+
+// NewReviewRevision keeps the current text and score of the review before
+// it is edited.
+func NewReviewRevision(review *Review) *ReviewRevision {
+	written := review.CreatedAt
+	if review.EditedAt != nil {
+		written = *review.EditedAt
+	}
+
+	return &ReviewRevision{
+		ReviewID:  review.ID,
+		Text:      review.Text,
+		Count:     review.Count,
+		WrittenAt: written,
+	}
+}
+
+func (r *ReviewRevision) ToPB() *pb.ReviewRevision {
+	return &pb.ReviewRevision{
+		Id:         uint64(r.ID),
+		ReviewId:   uint64(r.ReviewID),
+		Text:       r.Text,
+		Count:      int32(r.Count),
+		WrittenAt:  timestamppb.New(r.WrittenAt),
+		ReplacedAt: timestamppb.New(r.CreatedAt),
 	}
 }
@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+type ReviewOrder string
+
+const (
+	ReviewOrderCreatedAt ReviewOrder = "created_at"
+	ReviewOrderLikes     ReviewOrder = "likes"
+	ReviewOrderScore     ReviewOrder = "score"
+)
+
+// Column is the reviews column the order sorts by.
+func (o ReviewOrder) Column() string {
+	if o == ReviewOrderScore {
+		return "count"
+	}
+
+	return string(o)
+}
+
+// ReviewFilter selects and orders the reviews of a release for ListReviews.
+type ReviewFilter struct {
+	ReleaseID string      `json:"r"`
+	Kind      ReviewKind  `json:"k"`
+	MinScore  int         `json:"lo"`
+	MaxScore  int         `json:"hi"`
+	Language  string      `json:"l,omitempty"`
+	OrderBy   ReviewOrder `json:"o"`
+	Desc      bool        `json:"d,omitempty"`
+}
+
+// ReviewCursor is the sort key of the last review on a page.
+type ReviewCursor struct {
+	CreatedAt time.Time `json:"c"`
+	Likes     int64     `json:"l"`
+	Count     int       `json:"s"`
+	ID        uint      `json:"i"`
+}
+
+func NewReviewCursor(r *Review) *ReviewCursor {
+	return &ReviewCursor{
+		CreatedAt: r.CreatedAt,
+		Likes:     r.Likes,
+		Count:     r.Count,
+		ID:        r.ID,
+	}
+}
+
+// ReviewPage is one page of a review listing and the token of the next.
+type ReviewPage struct {
+	Reviews       []Review
+	NextPageToken string
+}
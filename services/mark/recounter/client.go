@@ -1,15 +1,44 @@
 package recounter
 
+// scoreChange is a review changing the score it gives its release; see
+// entity.Histogram.Move.
+type scoreChange struct {
+	releaseID string
+	removed   *int
+	added     *int
+}
+
 type Client struct {
-	output chan string
+	output chan<- scoreChange
+	done   <-chan struct{}
 }
 
-func newClient(output chan string) *Client {
+func newClient(output chan<- scoreChange, done <-chan struct{}) *Client {
 	return &Client{
 		output: output,
+		done:   done,
 	}
 }
 
-func (c *Client) TryRecount(releaseID string) {
-	c.output <- releaseID
+// Rescore hands a score change over to the recounter: removed is the score
+// a review of the release no longer gives, added the one it gives now,
+// either nil when the review is created or deleted. Changes sent after the
+// recounter stopped are dropped; the next startup reconciles them.
+func (c *Client) Rescore(releaseID string, removed, added *int) {
+	change := scoreChange{releaseID: releaseID}
+
+	if removed != nil {
+		score := *removed
+		change.removed = &score
+	}
+
+	if added != nil {
+		score := *added
+		change.added = &score
+	}
+
+	select {
+	case c.output <- change:
+	case <-c.done:
+	}
 }
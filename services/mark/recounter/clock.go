@@ -0,0 +1,18 @@
+package recounter
+
+import "time"
+
+// Clock is where the recounter takes its timers from, so that tests can
+// drive the debounce with a fake clock.
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock is the Clock of the running service.
+var SystemClock Clock = systemClock{}
@@ -2,97 +2,226 @@ package recounter
 
 import (
 	"context"
-	"sync"
+	"time"
 
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/mark/cache"
-	"github.com/osamikoyo/music-and-marks/services/mark/core"
+	"github.com/osamikoyo/music-and-marks/services/mark/config"
 	"github.com/osamikoyo/music-and-marks/services/mark/entity"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 )
 
-const CountToRecount = 10
+const (
+	DefaultFlushInterval   = 2 * time.Second
+	DefaultFlushSize       = 500
+	DefaultReweighInterval = 5 * time.Minute
 
+	// shutdownTimeout bounds the last flush once the app is stopping.
+	shutdownTimeout = 10 * time.Second
+
+	bufferSize = 128
+)
+
+type Repository interface {
+	ApplyMarkDeltas(ctx context.Context, deltas map[string]entity.Histogram, minVotes int) error
+	ReweighMarks(ctx context.Context, minVotes int) error
+	RebuildMarks(ctx context.Context, minVotes int) error
+	ListReleaseParents(ctx context.Context, releaseIDs []string) ([]entity.ReleaseParent, error)
+}
+
+// Cache holds the marks of release groups and artists, which go stale as
+// the marks of their releases change.
+type Cache interface {
+	Delete(key string)
+}
+
+// Recounter keeps the marks of releases in step with their reviews. Score
+// changes are summed up per release in memory and written in batches;
+// Reconcile recounts everything from the reviews.
 type Recounter struct {
-	cache    *cache.Cache
-	logger   *logger.Logger
-	repo     core.Repository
-	releases chan string
+	logger *logger.Logger
+	repo   Repository
+	cache  Cache
+	clock  Clock
+
+	input chan scoreChange
+	done  chan struct{}
+
+	// minVotes is the prior of the weighted marks, which are reweighed
+	// every reweighInterval as the global mean drifts.
+	minVotes        int
+	reweighInterval time.Duration
+
+	flushInterval time.Duration
+	flushSize     int
+
+	// pending holds the unwritten score changes per release, changes
+	// counts them. After a failed flush only the timer retries.
+	pending  map[string]entity.Histogram
+	changes  int
+	retrying bool
+}
+
+func NewRecounter(cfg *config.Config, repo Repository, cache Cache, clock Clock, logger *logger.Logger) (*Recounter, *Client) {
+	r := &Recounter{
+		logger:          logger,
+		repo:            repo,
+		cache:           cache,
+		clock:           clock,
+		input:           make(chan scoreChange, bufferSize),
+		done:            make(chan struct{}),
+		minVotes:        max(cfg.Rating.MinVotes, 0),
+		reweighInterval: orDefault(cfg.Rating.ReweighInterval, DefaultReweighInterval),
+		flushInterval:   orDefault(cfg.Recounter.FlushInterval, DefaultFlushInterval),
+		flushSize:       cfg.Recounter.FlushSize,
+		pending:         make(map[string]entity.Histogram),
+	}
+
+	if r.flushSize <= 0 {
+		r.flushSize = DefaultFlushSize
+	}
 
-	mu     sync.RWMutex
-	counts map[string]int
+	return r, newClient(r.input, r.done)
 }
 
-func NewRecounter(cache *cache.Cache, repo core.Repository, logger *logger.Logger) (*Recounter, *Client) {
-	releaes := make(chan string, 5)
+func orDefault(d, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
 
-	return &Recounter{
-		cache:  cache,
-		logger: logger,
-		repo:   repo,
-		counts: make(map[string]int),
-	}, newClient(releaes)
+	return d
 }
 
-func (r *Recounter) recountMark(ctx context.Context, releaeID string) error {
-	reviews, err := r.repo.GetReviewsByReleaseID(ctx, releaeID)
-	if err != nil {
-		return err
+// Reconcile rebuilds all marks from the reviews. It runs on startup, before
+// reviews are written, to pick up changes that were never flushed.
+func (r *Recounter) Reconcile(ctx context.Context) error {
+	r.logger.Info("reconciling marks...")
+
+	return r.repo.RebuildMarks(ctx, r.minVotes)
+}
+
+func (r *Recounter) record(change scoreChange) {
+	delta := r.pending[change.releaseID]
+	delta.Move(change.removed, change.added)
+	r.pending[change.releaseID] = delta
+
+	r.changes++
+}
+
+// flush writes the pending changes and reports whether it succeeded. Failed
+// changes stay pending for the next flush.
+func (r *Recounter) flush(ctx context.Context) bool {
+	if len(r.pending) == 0 {
+		return true
 	}
 
-	sum := 0
+	if err := r.repo.ApplyMarkDeltas(ctx, r.pending, r.minVotes); err != nil {
+		r.logger.Error("failed flush mark changes",
+			zap.Int("releases", len(r.pending)),
+			zap.Int("changes", r.changes),
+			zap.Error(err))
+
+		r.retrying = true
 
-	for _, review := range reviews {
-		sum += review.Count
+		return false
 	}
 
-	count := float32(sum / len(reviews))
+	r.logger.Info("flushed mark changes",
+		zap.Int("releases", len(r.pending)),
+		zap.Int("changes", r.changes))
 
-	mark := entity.NewMark(releaeID, count)
+	r.invalidate(ctx)
+
+	r.pending = make(map[string]entity.Histogram)
+	r.changes = 0
+	r.retrying = false
+
+	return true
+}
 
-	if err := r.repo.UpdateMarkByReleaseID(ctx, releaeID, mark); err != nil {
-		return err
+// invalidate drops the cached marks of the release groups and artists of
+// the pending releases.
+func (r *Recounter) invalidate(ctx context.Context) {
+	releaseIDs := make([]string, 0, len(r.pending))
+	for releaseID := range r.pending {
+		releaseIDs = append(releaseIDs, releaseID)
 	}
 
-	return nil
+	parents, err := r.repo.ListReleaseParents(ctx, releaseIDs)
+	if err != nil {
+		r.logger.Error("failed invalidate aggregated marks",
+			zap.Error(err))
+
+		return
+	}
+
+	for _, parent := range parents {
+		r.cache.Delete(cache.ReleaseGroupMarkKey(parent.ReleaseGroupID))
+		r.cache.Delete(cache.ArtistMarkKey(parent.ArtistID))
+	}
 }
 
-func (r *Recounter) Start(appctx context.Context) {
+// Start runs until ctx is done, writing score changes at most flushInterval
+// after they arrive.
+func (r *Recounter) Start(ctx context.Context) {
 	r.logger.Info("starting recounter...")
 
-	eg, ctx := errgroup.WithContext(appctx)
+	// nil while nothing is pending
+	var flush <-chan time.Time
+
+	reweigh := r.clock.After(r.reweighInterval)
 
 	for {
 		select {
-		case <-appctx.Done():
-			r.logger.Info("stopping recounter...")
-
-			close(r.releases)
+		case <-ctx.Done():
+			r.stop()
 
 			return
-		case releaseID := <-r.releases:
-			r.mu.Lock()
-			r.counts[releaseID]++
-			r.mu.Unlock()
-		default:
-			r.mu.Lock()
-			for release, count := range r.counts {
-				if count >= CountToRecount {
-					eg.Go(func() error {
-						return r.recountMark(ctx, release)
-					})
-
-					r.counts[release] = 0
-				}
-			}
+		case change := <-r.input:
+			r.record(change)
 
-			r.mu.Unlock()
+			if r.changes >= r.flushSize && !r.retrying {
+				r.flush(ctx)
+			}
+		case <-flush:
+			r.flush(ctx)
 
-			if err := eg.Wait(); err != nil {
-				r.logger.Error("failed recount mark",
+			flush = nil
+		case <-reweigh:
+			if err := r.repo.ReweighMarks(ctx, r.minVotes); err != nil {
+				r.logger.Error("failed reweigh marks",
 					zap.Error(err))
 			}
+
+			reweigh = r.clock.After(r.reweighInterval)
+		}
+
+		switch {
+		case len(r.pending) == 0:
+			flush = nil
+		case flush == nil:
+			flush = r.clock.After(r.flushInterval)
 		}
 	}
 }
+
+// stop releases blocked clients and writes what is left.
+func (r *Recounter) stop() {
+	r.logger.Info("stopping recounter...")
+
+	close(r.done)
+
+	for drained := false; !drained; {
+		select {
+		case change := <-r.input:
+			r.record(change)
+		default:
+			drained = true
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	r.flush(ctx)
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// chartBatchSize bounds the rows of one insert when charts are written.
+const chartBatchSize = 500
+
+// rankMarks turns the marks, best first, into the entries of a chart.
+func rankMarks(chart string, marks []entity.Mark, createdAt time.Time) []entity.ChartEntry {
+	entries := make([]entity.ChartEntry, len(marks))
+
+	for i, mark := range marks {
+		entries[i] = entity.ChartEntry{
+			Chart:     chart,
+			Rank:      i + 1,
+			ReleaseID: mark.ReleaseID,
+			Weighted:  mark.Weighted,
+			Value:     mark.Value,
+			Reviews:   mark.Reviews,
+			CreatedAt: createdAt,
+		}
+	}
+
+	return entries
+}
+
+// topMarks returns the marks with at least minReviews reviews of the
+// releases that came out between the years, both included, or of all
+// releases when from is 0, best first.
+func topMarks(tx *gorm.DB, from, to, minReviews int) ([]entity.Mark, error) {
+	query := tx.Model(&entity.Mark{}).
+		Where("marks.reviews >= ?", max(minReviews, 1))
+
+	if from != 0 {
+		query = query.
+			Joins("JOIN release_parents ON release_parents.release_id = marks.release_id").
+			Where("release_parents.year BETWEEN ? AND ?", from, to)
+	}
+
+	var marks []entity.Mark
+
+	err := query.
+		Order("marks.weighted DESC, marks.reviews DESC, marks.release_id").
+		Find(&marks).Error
+
+	return marks, err
+}
+
+// windowMarks sums up the visible reviews written since the given time
+// into marks weighed against the mean of those reviews, and returns those
+// with at least minReviews reviews, best first.
+func windowMarks(tx *gorm.DB, since time.Time, minReviews, minVotes int) ([]entity.Mark, error) {
+	var buckets []scoreBucket
+
+	err := tx.Model(&entity.Review{}).
+		Select("release_id, count, COUNT(*) AS reviews").
+		Where("created_at >= ? AND hidden_at IS NULL", since).
+		Group("release_id, count").
+		Scan(&buckets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byRelease := sumBuckets(buckets)
+
+	var sum, total float64
+
+	for _, mark := range byRelease {
+		sum += float64(mark.Value) * float64(mark.Reviews)
+		total += float64(mark.Reviews)
+	}
+
+	marks := make([]entity.Mark, 0, len(byRelease))
+
+	for _, mark := range byRelease {
+		if mark.Reviews < max(minReviews, 1) {
+			continue
+		}
+
+		mark.Weigh(sum/total, minVotes)
+		marks = append(marks, *mark)
+	}
+
+	sort.Slice(marks, func(i, j int) bool {
+		a, b := marks[i], marks[j]
+
+		if a.Weighted != b.Weighted {
+			return a.Weighted > b.Weighted
+		}
+
+		if a.Reviews != b.Reviews {
+			return a.Reviews > b.Reviews
+		}
+
+		return a.ReleaseID < b.ReleaseID
+	})
+
+	return marks, nil
+}
+
+// RebuildCharts recomputes every chart, ranking all releases with at least
+// minReviews reviews in each: all time, every year and decade releases came
+// out in, and the rolling windows of entity.ChartWindows. Charts are kept
+// whole so that filtered listings rank among all of their releases, not
+// only the best of the chart. The old charts are replaced at once.
+func (r *Repository) RebuildCharts(ctx context.Context, minReviews, minVotes int) error {
+	r.logger.Info("rebuilding charts...")
+
+	now := time.Now()
+	db := r.db.WithContext(ctx)
+
+	var entries []entity.ChartEntry
+
+	add := func(chart entity.Chart, marks []entity.Mark, err error) error {
+		if err != nil {
+			r.logger.Error("failed rank chart",
+				zap.String("chart", chart.Key()),
+				zap.Error(err))
+
+			return ErrInternal
+		}
+
+		entries = append(entries, rankMarks(chart.Key(), marks, now)...)
+
+		return nil
+	}
+
+	marks, err := topMarks(db, 0, 0, minReviews)
+	if err = add(entity.Chart{Period: entity.ChartAllTime}, marks, err); err != nil {
+		return err
+	}
+
+	var years []int
+
+	err = db.Model(&entity.ReleaseParent{}).
+		Where("year > 0").
+		Distinct("year").
+		Order("year").
+		Pluck("year", &years).Error
+	if err != nil {
+		r.logger.Error("failed fetch release years",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	decades := make(map[int]bool)
+
+	for _, year := range years {
+		marks, err = topMarks(db, year, year, minReviews)
+		if err = add(entity.Chart{Period: entity.ChartYear, Year: year}, marks, err); err != nil {
+			return err
+		}
+
+		decades[year-year%10] = true
+	}
+
+	for decade := range decades {
+		marks, err = topMarks(db, decade, decade+9, minReviews)
+		if err = add(entity.Chart{Period: entity.ChartDecade, Year: decade}, marks, err); err != nil {
+			return err
+		}
+	}
+
+	for period, window := range entity.ChartWindows {
+		marks, err = windowMarks(db, now.Add(-window), minReviews, minVotes)
+		if err = add(entity.Chart{Period: period}, marks, err); err != nil {
+			return err
+		}
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&entity.ChartEntry{}).Error; err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			return nil
+		}
+
+		return tx.CreateInBatches(entries, chartBatchSize).Error
+	})
+	if err != nil {
+		r.logger.Error("failed write charts",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	r.logger.Info("charts rebuilt",
+		zap.Int("years", len(years)),
+		zap.Int("entries", len(entries)))
+
+	return nil
+}
+
+// ListChart returns up to limit entries of the chart matching the filter
+// in the order of their rank, starting right after the cursor when one is
+// given.
+func (r *Repository) ListChart(ctx context.Context, chart entity.Chart, filter entity.ChartFilter, after *entity.ChartCursor, limit int) ([]entity.ChartEntry, error) {
+	r.logger.Info("listing chart...",
+		zap.String("chart", chart.Key()),
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	query := r.db.WithContext(ctx).
+		Model(&entity.ChartEntry{}).
+		Where("chart = ?", chart.Key())
+
+	if filter.Country != "" || filter.PrimaryType != "" {
+		parents := r.db.Model(&entity.ReleaseParent{}).Select("release_id")
+
+		if filter.Country != "" {
+			parents = parents.Where("country = ?", filter.Country)
+		}
+
+		if filter.PrimaryType != "" {
+			parents = parents.Where("primary_type = ?", filter.PrimaryType)
+		}
+
+		query = query.Where("release_id IN (?)", parents)
+	}
+
+	if filter.Genre != "" {
+		query = query.Where("release_id IN (?)",
+			r.db.Model(&entity.ReleaseGenre{}).Select("release_id").Where("genre = ?", filter.Genre))
+	}
+
+	if after != nil {
+		query = query.Where("rank > ?", after.Rank)
+	}
+
+	var entries []entity.ChartEntry
+
+	err := query.
+		Order("rank").
+		Limit(limit).
+		Find(&entries).Error
+	if err != nil {
+		r.logger.Error("failed list chart",
+			zap.String("chart", chart.Key()),
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return entries, nil
+}
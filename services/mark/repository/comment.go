@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// CreateComment stores the comment and counts it on its review and parent.
+func (r *Repository) CreateComment(ctx context.Context, comment *entity.Comment) error {
+	r.logger.Info("creating comment",
+		zap.Uint("review_id", comment.ReviewID))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+
+		if comment.ParentID != nil {
+			err := tx.Model(&entity.Comment{}).
+				Where("id = ?", *comment.ParentID).
+				UpdateColumn("replies", gorm.Expr("replies + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&entity.Review{}).
+			Where("id = ?", comment.ReviewID).
+			UpdateColumn("comments", gorm.Expr("comments + 1")).Error
+	})
+	if err != nil {
+		r.logger.Error("failed create comment",
+			zap.Uint("review_id", comment.ReviewID),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) GetComment(ctx context.Context, id uint) (*entity.Comment, error) {
+	r.logger.Info("fetching comment",
+		zap.Uint("id", id))
+
+	var comment entity.Comment
+
+	if err := r.db.WithContext(ctx).First(&comment, id).Error; err != nil {
+		r.logger.Error("failed fetch comment",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &comment, nil
+}
+
+func (r *Repository) EditComment(ctx context.Context, comment *entity.Comment) error {
+	r.logger.Info("editing comment",
+		zap.Uint("id", comment.ID))
+
+	err := r.db.WithContext(ctx).
+		Model(comment).
+		Select("text", "edited_at").
+		Updates(comment).Error
+	if err != nil {
+		r.logger.Error("failed edit comment",
+			zap.Uint("id", comment.ID),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// DeleteComment removes the comment, or keeps it without its text and
+// author while it has replies. Deleted parents left without replies are
+// removed as well.
+func (r *Repository) DeleteComment(ctx context.Context, comment *entity.Comment) error {
+	r.logger.Info("deleting comment",
+		zap.Uint("id", comment.ID))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Review{}).
+			Where("id = ?", comment.ReviewID).
+			UpdateColumn("comments", gorm.Expr("comments - 1")).Error
+		if err != nil {
+			return err
+		}
+
+		if comment.Replies > 0 {
+			return tx.Model(comment).
+				Select("text", "user_id", "deleted").
+				Updates(&entity.Comment{Deleted: true}).Error
+		}
+
+		current := comment
+
+		for {
+			if err = tx.Delete(&entity.Comment{}, current.ID).Error; err != nil {
+				return err
+			}
+
+			if current.ParentID == nil {
+				return nil
+			}
+
+			var parent entity.Comment
+
+			if err = tx.First(&parent, *current.ParentID).Error; err != nil {
+				return err
+			}
+
+			parent.Replies--
+
+			if !parent.Deleted || parent.Replies > 0 {
+				return tx.Model(&parent).UpdateColumn("replies", parent.Replies).Error
+			}
+
+			current = &parent
+		}
+	})
+	if err != nil {
+		r.logger.Error("failed delete comment",
+			zap.Uint("id", comment.ID),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ListComments returns up to limit comments matching the filter, oldest
+// first, starting right after the cursor when one is given.
+func (r *Repository) ListComments(ctx context.Context, filter entity.CommentFilter, after *entity.CommentCursor, limit int) ([]entity.Comment, error) {
+	r.logger.Info("fetching comments",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	query := r.db.WithContext(ctx).Where("review_id = ?", filter.ReviewID)
+
+	if filter.ParentID == 0 {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", filter.ParentID)
+	}
+
+	if after != nil {
+		query = query.Where(
+			"created_at > ? OR (created_at = ? AND id > ?)",
+			after.CreatedAt, after.CreatedAt, after.ID,
+		)
+	}
+
+	var comments []entity.Comment
+
+	err := query.
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		r.logger.Error("failed fetch comments",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return comments, nil
+}
+
+// ListReplies returns the first perParent replies to each of the given
+// comments, oldest first, and at most limit replies in all.
+func (r *Repository) ListReplies(ctx context.Context, parentIDs []uint, perParent, limit int) ([]entity.Comment, error) {
+	r.logger.Info("fetching replies",
+		zap.Int("parents", len(parentIDs)),
+		zap.Int("per_parent", perParent))
+
+	ranked := r.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Select("*, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY created_at ASC, id ASC) AS reply_rank").
+		Where("parent_id IN ?", parentIDs)
+
+	var replies []entity.Comment
+
+	err := r.db.WithContext(ctx).
+		Table("(?) AS comments", ranked).
+		Where("reply_rank <= ?", perParent).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&replies).Error
+	if err != nil {
+		r.logger.Error("failed fetch replies",
+			zap.Int("parents", len(parentIDs)),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return replies, nil
+}
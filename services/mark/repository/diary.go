@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateDiaryEntry(ctx context.Context, entry *entity.DiaryEntry) error {
+	r.logger.Info("creating diary entry",
+		zap.Any("entry", entry))
+
+	if err := r.db.WithContext(ctx).Create(entry).Error; err != nil {
+		r.logger.Error("failed create diary entry",
+			zap.Any("entry", entry),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) GetDiaryEntry(ctx context.Context, id uint) (*entity.DiaryEntry, error) {
+	r.logger.Info("fetching diary entry",
+		zap.Uint("id", id))
+
+	var entry entity.DiaryEntry
+
+	if err := r.db.WithContext(ctx).First(&entry, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		r.logger.Error("failed fetch diary entry",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &entry, nil
+}
+
+// EditDiaryEntry writes the date, score, review and relisten flag of the
+// entry.
+func (r *Repository) EditDiaryEntry(ctx context.Context, entry *entity.DiaryEntry) error {
+	r.logger.Info("editing diary entry",
+		zap.Any("entry", entry))
+
+	err := r.db.WithContext(ctx).
+		Model(entry).
+		Select("listened_on", "score", "review_id", "relisten", "edited_at").
+		Updates(entry).Error
+	if err != nil {
+		r.logger.Error("failed edit diary entry",
+			zap.Any("entry", entry),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteDiaryEntry(ctx context.Context, id uint) error {
+	r.logger.Info("deleting diary entry",
+		zap.Uint("id", id))
+
+	res := r.db.WithContext(ctx).Delete(&entity.DiaryEntry{}, id)
+	if res.Error != nil {
+		r.logger.Error("failed delete diary entry",
+			zap.Uint("id", id),
+			zap.Error(res.Error))
+
+		return ErrInternal
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListDiary returns up to limit entries of the user in the period of the
+// filter, latest listens first, starting right after the cursor when one is
+// given.
+func (r *Repository) ListDiary(ctx context.Context, filter entity.DiaryFilter, after *entity.DiaryCursor, limit int) ([]entity.DiaryEntry, error) {
+	r.logger.Info("listing diary...",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	from, to := filter.Span()
+
+	query := r.db.WithContext(ctx).
+		Where("user_id = ? AND listened_on >= ? AND listened_on < ?", filter.UserID, from, to)
+
+	if after != nil {
+		query = query.Where("listened_on < ? OR (listened_on = ? AND id < ?)",
+			after.ListenedOn, after.ListenedOn, after.ID)
+	}
+
+	var entries []entity.DiaryEntry
+
+	err := query.
+		Order("listened_on DESC, id DESC").
+		Limit(limit).
+		Find(&entries).Error
+	if err != nil {
+		r.logger.Error("failed list diary",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return entries, nil
+}
+
+// GetDiaryStats sums up the entries of the user in the year and counts the
+// topArtists artists logged most.
+func (r *Repository) GetDiaryStats(ctx context.Context, userID string, year, topArtists int) (*entity.DiaryStats, error) {
+	r.logger.Info("fetching diary stats",
+		zap.String("user_id", userID),
+		zap.Int("year", year))
+
+	from, to := entity.DiaryFilter{UserID: userID, Year: year}.Span()
+
+	var totals struct {
+		Entries      int64
+		Releases     int64
+		Relistens    int64
+		AverageScore *float64
+	}
+
+	stats := entity.DiaryStats{
+		Year:       year,
+		TopArtists: []entity.ArtistListens{},
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&entity.DiaryEntry{}).
+		Select("COUNT(*) AS entries, COUNT(DISTINCT release_id) AS releases, "+
+			"COALESCE(SUM(CASE WHEN relisten THEN 1 ELSE 0 END), 0) AS relistens, AVG(score) AS average_score").
+		Where("user_id = ? AND listened_on >= ? AND listened_on < ?", userID, from, to).
+		Scan(&totals).Error
+	if err == nil {
+		err = r.db.WithContext(ctx).
+			Model(&entity.DiaryEntry{}).
+			Select("release_parents.artist_id, COUNT(*) AS entries").
+			Joins("JOIN release_parents ON release_parents.release_id = diary_entries.release_id").
+			Where("diary_entries.user_id = ? AND diary_entries.listened_on >= ? AND diary_entries.listened_on < ?", userID, from, to).
+			Where("release_parents.artist_id <> ''").
+			Group("release_parents.artist_id").
+			Order("entries DESC, release_parents.artist_id").
+			Limit(topArtists).
+			Scan(&stats.TopArtists).Error
+	}
+	if err != nil {
+		r.logger.Error("failed fetch diary stats",
+			zap.String("user_id", userID),
+			zap.Int("year", year),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	stats.Entries = totals.Entries
+	stats.Releases = totals.Releases
+	stats.Relistens = totals.Relistens
+	stats.AverageScore = totals.AverageScore
+
+	return &stats, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// LikeReview adds the like unless the user already likes the review and
+// returns the like count of the review and whether the like was added.
+func (r *Repository) LikeReview(ctx context.Context, like *entity.ReviewLike) (int64, bool, error) {
+	r.logger.Info("liking review",
+		zap.Uint("review_id", like.ReviewID),
+		zap.String("user_id", like.UserID))
+
+	var (
+		review entity.Review
+		added  bool
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where(like).FirstOrCreate(like)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected > 0 {
+			added = true
+
+			err := tx.Model(&entity.Review{}).
+				Where("id = ?", like.ReviewID).
+				UpdateColumn("likes", gorm.Expr("likes + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Select("likes").First(&review, like.ReviewID).Error
+	})
+	if err != nil {
+		r.logger.Error("failed like review",
+			zap.Uint("review_id", like.ReviewID),
+			zap.String("user_id", like.UserID),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, ErrNotFound
+		}
+
+		return 0, false, ErrInternal
+	}
+
+	return review.Likes, added, nil
+}
+
+// UnlikeReview is LikeReview in reverse.
+func (r *Repository) UnlikeReview(ctx context.Context, reviewID uint, userID string) (int64, bool, error) {
+	r.logger.Info("unliking review",
+		zap.Uint("review_id", reviewID),
+		zap.String("user_id", userID))
+
+	var (
+		review  entity.Review
+		removed bool
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("review_id = ? AND user_id = ?", reviewID, userID).Delete(&entity.ReviewLike{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected > 0 {
+			removed = true
+
+			err := tx.Model(&entity.Review{}).
+				Where("id = ?", reviewID).
+				UpdateColumn("likes", gorm.Expr("likes - 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Select("likes").First(&review, reviewID).Error
+	})
+	if err != nil {
+		r.logger.Error("failed unlike review",
+			zap.Uint("review_id", reviewID),
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, ErrNotFound
+		}
+
+		return 0, false, ErrInternal
+	}
+
+	return review.Likes, removed, nil
+}
+
+// ListReviewLikes returns up to limit likes of the review, newest first,
+// starting right after the cursor when one is given.
+func (r *Repository) ListReviewLikes(ctx context.Context, reviewID uint, after *entity.ReviewLikeCursor, limit int) ([]entity.ReviewLike, error) {
+	r.logger.Info("fetching review likes",
+		zap.Uint("review_id", reviewID),
+		zap.Int("limit", limit))
+
+	query := r.db.WithContext(ctx).Where("review_id = ?", reviewID)
+
+	if after != nil {
+		query = query.Where(
+			"created_at < ? OR (created_at = ? AND user_id < ?)",
+			after.CreatedAt, after.CreatedAt, after.UserID,
+		)
+	}
+
+	var likes []entity.ReviewLike
+
+	err := query.
+		Order("created_at DESC, user_id DESC").
+		Limit(limit).
+		Find(&likes).Error
+	if err != nil {
+		r.logger.Error("failed fetch review likes",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return likes, nil
+}
@@ -0,0 +1,483 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateList(ctx context.Context, list *entity.List) error {
+	r.logger.Info("creating list",
+		zap.Any("list", list))
+
+	if err := r.db.WithContext(ctx).Create(list).Error; err != nil {
+		r.logger.Error("failed create list",
+			zap.Any("list", list),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) GetList(ctx context.Context, id uint) (*entity.List, error) {
+	r.logger.Info("fetching list",
+		zap.Uint("id", id))
+
+	var list entity.List
+
+	if err := r.db.WithContext(ctx).First(&list, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		r.logger.Error("failed fetch list",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &list, nil
+}
+
+// UpdateList writes the name, description and visibility of the list.
+func (r *Repository) UpdateList(ctx context.Context, list *entity.List) error {
+	r.logger.Info("updating list",
+		zap.Any("list", list))
+
+	err := r.db.WithContext(ctx).
+		Model(list).
+		Select("name", "description", "public", "edited_at").
+		Updates(list).Error
+	if err != nil {
+		r.logger.Error("failed update list",
+			zap.Any("list", list),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// DeleteList removes the list along with its items and likes.
+func (r *Repository) DeleteList(ctx context.Context, id uint) error {
+	r.logger.Info("deleting list",
+		zap.Uint("id", id))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("list_id = ?", id).Delete(&entity.ListItem{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("list_id = ?", id).Delete(&entity.ListLike{}).Error; err != nil {
+			return err
+		}
+
+		res := tx.Delete(&entity.List{}, id)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+
+		r.logger.Error("failed delete list",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ListLists returns up to limit lists matching the filter in its order,
+// starting right after the cursor when one is given.
+func (r *Repository) ListLists(ctx context.Context, filter entity.ListFilter, after *entity.ListCursor, limit int) ([]entity.List, error) {
+	r.logger.Info("listing lists...",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	query := r.db.WithContext(ctx).Model(&entity.List{})
+
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+
+	if !filter.Private {
+		query = query.Where("public = ?", true)
+	}
+
+	if filter.ReleaseID != "" {
+		query = query.Where("id IN (?)",
+			r.db.Model(&entity.ListItem{}).Select("list_id").Where("release_id = ?", filter.ReleaseID))
+	}
+
+	column := string(filter.OrderBy)
+
+	if after != nil {
+		var value any = after.CreatedAt
+		if filter.OrderBy == entity.ListOrderLikes {
+			value = after.Likes
+		}
+
+		query = query.Where(
+			fmt.Sprintf("%[1]s < ? OR (%[1]s = ? AND id < ?)", column),
+			value, value, after.ID,
+		)
+	}
+
+	var lists []entity.List
+
+	err := query.
+		Order(fmt.Sprintf("%s DESC, id DESC", column)).
+		Limit(limit).
+		Find(&lists).Error
+	if err != nil {
+		r.logger.Error("failed list lists",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return lists, nil
+}
+
+// clampPosition keeps a position within the first and the last of n
+// items, a position out of range standing for the last one.
+func clampPosition(position, n int) int {
+	if position < 1 || position > n {
+		return n
+	}
+
+	return position
+}
+
+// touchList bumps the item count of the list by delta.
+func touchList(tx *gorm.DB, listID uint, delta int) error {
+	return tx.Model(&entity.List{}).
+		Where("id = ?", listID).
+		UpdateColumn("items", gorm.Expr("items + ?", delta)).Error
+}
+
+// AddListItem inserts the item at its position, at the end of the list
+// when the position is out of range, and shifts the items after it.
+// ErrAlreadyExist means the release is in the list already.
+func (r *Repository) AddListItem(ctx context.Context, item *entity.ListItem) error {
+	r.logger.Info("adding list item",
+		zap.Any("item", item))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var list entity.List
+
+		if err := tx.Select("items").First(&list, item.ListID).Error; err != nil {
+			return err
+		}
+
+		item.Position = clampPosition(item.Position, list.Items+1)
+
+		err := tx.Model(&entity.ListItem{}).
+			Where("list_id = ? AND position >= ?", item.ListID, item.Position).
+			UpdateColumn("position", gorm.Expr("position + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		if err = tx.Create(item).Error; err != nil {
+			return err
+		}
+
+		return touchList(tx, item.ListID, 1)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrAlreadyExist
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+
+		r.logger.Error("failed add list item",
+			zap.Any("item", item),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) GetListItem(ctx context.Context, id uint) (*entity.ListItem, error) {
+	r.logger.Info("fetching list item",
+		zap.Uint("id", id))
+
+	var item entity.ListItem
+
+	if err := r.db.WithContext(ctx).First(&item, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		r.logger.Error("failed fetch list item",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &item, nil
+}
+
+// EditListItem writes the note of the item.
+func (r *Repository) EditListItem(ctx context.Context, item *entity.ListItem) error {
+	r.logger.Info("editing list item",
+		zap.Any("item", item))
+
+	if err := r.db.WithContext(ctx).Model(item).Select("note").Updates(item).Error; err != nil {
+		r.logger.Error("failed edit list item",
+			zap.Any("item", item),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// MoveListItem puts the item at the position, the last one when it is out
+// of range, and shifts the items in between by one.
+func (r *Repository) MoveListItem(ctx context.Context, id uint, position int) (*entity.ListItem, error) {
+	r.logger.Info("moving list item",
+		zap.Uint("id", id),
+		zap.Int("position", position))
+
+	var item entity.ListItem
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&item, id).Error; err != nil {
+			return err
+		}
+
+		var list entity.List
+
+		if err := tx.Select("items").First(&list, item.ListID).Error; err != nil {
+			return err
+		}
+
+		from, to := item.Position, clampPosition(position, list.Items)
+		if from == to {
+			return nil
+		}
+
+		shift := tx.Model(&entity.ListItem{}).Where("list_id = ?", item.ListID)
+
+		if to < from {
+			shift = shift.Where("position >= ? AND position < ?", to, from).
+				UpdateColumn("position", gorm.Expr("position + 1"))
+		} else {
+			shift = shift.Where("position > ? AND position <= ?", from, to).
+				UpdateColumn("position", gorm.Expr("position - 1"))
+		}
+
+		if shift.Error != nil {
+			return shift.Error
+		}
+
+		item.Position = to
+
+		return tx.Model(&item).UpdateColumn("position", to).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		r.logger.Error("failed move list item",
+			zap.Uint("id", id),
+			zap.Int("position", position),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &item, nil
+}
+
+// RemoveListItem deletes the item and closes the gap it leaves.
+func (r *Repository) RemoveListItem(ctx context.Context, item *entity.ListItem) error {
+	r.logger.Info("removing list item",
+		zap.Any("item", item))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Delete(&entity.ListItem{}, item.ID)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		err := tx.Model(&entity.ListItem{}).
+			Where("list_id = ? AND position > ?", item.ListID, item.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+		if err != nil {
+			return err
+		}
+
+		return touchList(tx, item.ListID, -1)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+
+		r.logger.Error("failed remove list item",
+			zap.Any("item", item),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ListListItems returns up to limit items of the list in order, starting
+// right after the cursor when one is given.
+func (r *Repository) ListListItems(ctx context.Context, listID uint, after *entity.ListItemCursor, limit int) ([]entity.ListItem, error) {
+	r.logger.Info("fetching list items",
+		zap.Uint("list_id", listID),
+		zap.Int("limit", limit))
+
+	query := r.db.WithContext(ctx).Where("list_id = ?", listID)
+
+	if after != nil {
+		query = query.Where("position > ?", after.Position)
+	}
+
+	var items []entity.ListItem
+
+	err := query.
+		Order("position").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		r.logger.Error("failed fetch list items",
+			zap.Uint("list_id", listID),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return items, nil
+}
+
+// LikeList adds the like unless the user already likes the list and
+// returns the like count of the list and whether the like was added.
+func (r *Repository) LikeList(ctx context.Context, like *entity.ListLike) (int64, bool, error) {
+	r.logger.Info("liking list",
+		zap.Uint("list_id", like.ListID),
+		zap.String("user_id", like.UserID))
+
+	var (
+		list  entity.List
+		added bool
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where(like).FirstOrCreate(like)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected > 0 {
+			added = true
+
+			err := tx.Model(&entity.List{}).
+				Where("id = ?", like.ListID).
+				UpdateColumn("likes", gorm.Expr("likes + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Select("likes").First(&list, like.ListID).Error
+	})
+	if err != nil {
+		r.logger.Error("failed like list",
+			zap.Uint("list_id", like.ListID),
+			zap.String("user_id", like.UserID),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, ErrNotFound
+		}
+
+		return 0, false, ErrInternal
+	}
+
+	return list.Likes, added, nil
+}
+
+// UnlikeList is LikeList in reverse.
+func (r *Repository) UnlikeList(ctx context.Context, listID uint, userID string) (int64, bool, error) {
+	r.logger.Info("unliking list",
+		zap.Uint("list_id", listID),
+		zap.String("user_id", userID))
+
+	var (
+		list    entity.List
+		removed bool
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("list_id = ? AND user_id = ?", listID, userID).Delete(&entity.ListLike{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected > 0 {
+			removed = true
+
+			err := tx.Model(&entity.List{}).
+				Where("id = ?", listID).
+				UpdateColumn("likes", gorm.Expr("likes - 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Select("likes").First(&list, listID).Error
+	})
+	if err != nil {
+		r.logger.Error("failed unlike list",
+			zap.Uint("list_id", listID),
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, ErrNotFound
+		}
+
+		return 0, false, ErrInternal
+	}
+
+	return list.Likes, removed, nil
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// markColumns are the columns of a mark derived from its reviews.
+var markColumns = []string{"value", "reviews", "median", "std_dev", "histogram", "weighted", "updated_at"}
+
+func upsertMark(tx *gorm.DB, mark *entity.Mark) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "release_id"}},
+		DoUpdates: clause.AssignmentColumns(markColumns),
+	}).Create(mark).Error
+}
+
+// ApplyMarkDeltas adds histograms of score changes to the marks of their
+// releases and weighs the changed marks, all in one transaction.
+func (r *Repository) ApplyMarkDeltas(ctx context.Context, deltas map[string]entity.Histogram, minVotes int) error {
+	r.logger.Info("applying mark deltas",
+		zap.Int("releases", len(deltas)))
+
+	releaseIDs := make([]string, 0, len(deltas))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for releaseID, delta := range deltas {
+			var mark entity.Mark
+
+			err := tx.Where("release_id = ?", releaseID).First(&mark).Error
+			if err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					return err
+				}
+
+				mark = *entity.NewMark(releaseID)
+			}
+
+			mark.Apply(delta)
+
+			if err = tx.Save(&mark).Error; err != nil {
+				return err
+			}
+
+			releaseIDs = append(releaseIDs, releaseID)
+		}
+
+		return weighMarks(tx, minVotes, releaseIDs)
+	})
+	if err != nil {
+		r.logger.Error("failed apply mark deltas",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// DedupeMarks keeps the newest mark of every release. It runs before the
+// unique index on marks is created; the marks are recounted afterwards.
+func (r *Repository) DedupeMarks(ctx context.Context) error {
+	r.logger.Info("removing duplicate marks")
+
+	err := r.db.WithContext(ctx).
+		Where("id NOT IN (?)", r.db.Model(&entity.Mark{}).Select("MAX(id)").Group("release_id")).
+		Delete(&entity.Mark{}).Error
+	if err != nil {
+		r.logger.Error("failed remove duplicate marks",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// scoreBucket counts the reviews of a release with one score.
+type scoreBucket struct {
+	ReleaseID string
+	Count     int
+	Reviews   int64
+}
+
+// sumBuckets sums up the buckets into a mark per release.
+func sumBuckets(buckets []scoreBucket) map[string]*entity.Mark {
+	histograms := make(map[string]*entity.Histogram)
+
+	for _, bucket := range buckets {
+		histogram, ok := histograms[bucket.ReleaseID]
+		if !ok {
+			histogram = new(entity.Histogram)
+			histograms[bucket.ReleaseID] = histogram
+		}
+
+		histogram.Add(bucket.Count, bucket.Reviews)
+	}
+
+	marks := make(map[string]*entity.Mark, len(histograms))
+
+	for releaseID, histogram := range histograms {
+		mark := entity.NewMark(releaseID)
+		mark.Apply(*histogram)
+		marks[releaseID] = mark
+	}
+
+	return marks
+}
+
+// RebuildMarks recounts and weighs the marks of all releases from their
+// reviews. Marks are updated in place so that their ids stay the same.
+func (r *Repository) RebuildMarks(ctx context.Context, minVotes int) error {
+	r.logger.Info("rebuilding marks")
+
+	var buckets []scoreBucket
+
+	err := r.db.WithContext(ctx).
+		Model(&entity.Review{}).
+		Select("release_id, count, COUNT(*) AS reviews").
+		Where("hidden_at IS NULL").
+		Group("release_id, count").
+		Scan(&buckets).Error
+	if err != nil {
+		r.logger.Error("failed count reviews by score",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	marks := sumBuckets(buckets)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// releases whose reviews are all gone keep an empty mark
+		err := tx.Model(&entity.Mark{}).
+			Where("1 = 1").
+			Select(markColumns).
+			Updates(&entity.Mark{}).Error
+		if err != nil {
+			return err
+		}
+
+		for _, mark := range marks {
+			if err := upsertMark(tx, mark); err != nil {
+				return err
+			}
+		}
+
+		return weighMarks(tx, minVotes, nil)
+	})
+	if err != nil {
+		r.logger.Error("failed rebuild marks",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	r.logger.Info("marks rebuilt",
+		zap.Int("releases", len(marks)))
+
+	return nil
+}
+
+func globalMean(tx *gorm.DB) (float64, error) {
+	var mean float64
+
+	err := tx.Model(&entity.Mark{}).
+		Select("COALESCE(SUM(value * reviews) / SUM(reviews), 0)").
+		Scan(&mean).Error
+
+	return mean, err
+}
+
+// weighMarks sets the weighted value of the marks of the given releases, or
+// of all marks when releaseIDs is nil, the same way Mark.Weigh does.
+func weighMarks(tx *gorm.DB, minVotes int, releaseIDs []string) error {
+	mean, err := globalMean(tx)
+	if err != nil {
+		return err
+	}
+
+	query := tx.Model(&entity.Mark{})
+	if releaseIDs != nil {
+		query = query.Where("release_id IN ?", releaseIDs)
+	} else {
+		query = query.Where("1 = 1")
+	}
+
+	return query.UpdateColumn("weighted", gorm.Expr(
+		"CASE WHEN reviews = 0 THEN 0 ELSE (reviews * value + ? * ?) / (reviews + ?) END",
+		minVotes, mean, minVotes,
+	)).Error
+}
+
+// ReweighMarks weighs all marks against the current global mean, which
+// drifts with every review.
+func (r *Repository) ReweighMarks(ctx context.Context, minVotes int) error {
+	r.logger.Info("reweighing marks",
+		zap.Int("min_votes", minVotes))
+
+	if err := weighMarks(r.db.WithContext(ctx), minVotes, nil); err != nil {
+		r.logger.Error("failed reweigh marks",
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ListMarks returns up to limit marks of reviewed releases ordered by the
+// filter, starting right after the cursor when one is given.
+func (r *Repository) ListMarks(ctx context.Context, filter entity.MarkFilter, after *entity.MarkCursor, limit int) ([]entity.Mark, error) {
+	r.logger.Info("listing marks...",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	column := string(filter.OrderBy)
+
+	dir, cmp := "ASC", ">"
+	if filter.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&entity.Mark{}).
+		Where("reviews >= ?", max(filter.MinReviews, 1))
+
+	if after != nil {
+		var value any
+
+		switch filter.OrderBy {
+		case entity.MarkOrderValue:
+			value = after.Value
+		case entity.MarkOrderReviews:
+			value = after.Reviews
+		default:
+			value = after.Weighted
+		}
+
+		query = query.Where(
+			fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", column, cmp),
+			value, value, after.ID,
+		)
+	}
+
+	var marks []entity.Mark
+
+	err := query.
+		Order(fmt.Sprintf("%s %s, id %s", column, dir, dir)).
+		Limit(limit).
+		Find(&marks).Error
+	if err != nil {
+		r.logger.Error("failed list marks",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return marks, nil
+}
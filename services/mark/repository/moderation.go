@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// CreateReport stores the report and returns the number of open reports on
+// its review, ErrAlreadyExist when the user reported the review before.
+func (r *Repository) CreateReport(ctx context.Context, report *entity.ReviewReport) (int64, error) {
+	r.logger.Info("creating report",
+		zap.Uint("review_id", report.ReviewID),
+		zap.String("user_id", report.UserID))
+
+	var open int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(report).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.ReviewReport{}).
+			Where("review_id = ? AND resolved_at IS NULL", report.ReviewID).
+			Count(&open).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return 0, ErrAlreadyExist
+		}
+
+		r.logger.Error("failed create report",
+			zap.Uint("review_id", report.ReviewID),
+			zap.String("user_id", report.UserID),
+			zap.Error(err))
+
+		return 0, ErrInternal
+	}
+
+	return open, nil
+}
+
+// resolveReports closes the open reports of the review.
+func resolveReports(tx *gorm.DB, reviewID uint) (int64, error) {
+	res := tx.Model(&entity.ReviewReport{}).
+		Where("review_id = ? AND resolved_at IS NULL", reviewID).
+		UpdateColumn("resolved_at", time.Now())
+
+	return res.RowsAffected, res.Error
+}
+
+// setHidden hides or restores the review and tells whether it changed.
+func setHidden(tx *gorm.DB, review *entity.Review, hidden bool) (bool, error) {
+	if (review.HiddenAt != nil) == hidden {
+		return false, nil
+	}
+
+	var hiddenAt *time.Time
+	if hidden {
+		now := time.Now()
+		hiddenAt = &now
+	}
+
+	err := tx.Model(review).UpdateColumn("hidden_at", hiddenAt).Error
+	if err != nil {
+		return false, err
+	}
+
+	review.HiddenAt = hiddenAt
+
+	return true, nil
+}
+
+// HideReview hides the review, closes its open reports when resolve is set
+// and logs the entry unless nothing changed. It returns the review and
+// whether it was visible before.
+func (r *Repository) HideReview(ctx context.Context, entry *entity.ModerationLog, resolve bool) (*entity.Review, bool, error) {
+	r.logger.Info("hiding review",
+		zap.Any("entry", entry))
+
+	var (
+		review  entity.Review
+		changed bool
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&review, entry.ReviewID).Error; err != nil {
+			return err
+		}
+
+		var (
+			resolved int64
+			err      error
+		)
+
+		if changed, err = setHidden(tx, &review, true); err != nil {
+			return err
+		}
+
+		if resolve {
+			if resolved, err = resolveReports(tx, review.ID); err != nil {
+				return err
+			}
+		}
+
+		if !changed && resolved == 0 {
+			return nil
+		}
+
+		return tx.Create(entry).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, ErrNotFound
+		}
+
+		r.logger.Error("failed hide review",
+			zap.Any("entry", entry),
+			zap.Error(err))
+
+		return nil, false, ErrInternal
+	}
+
+	return &review, changed, nil
+}
+
+// RestoreReview is HideReview in reverse; it always closes the open reports.
+func (r *Repository) RestoreReview(ctx context.Context, entry *entity.ModerationLog) (*entity.Review, bool, error) {
+	r.logger.Info("restoring review",
+		zap.Any("entry", entry))
+
+	var (
+		review  entity.Review
+		changed bool
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&review, entry.ReviewID).Error; err != nil {
+			return err
+		}
+
+		var err error
+
+		if changed, err = setHidden(tx, &review, false); err != nil {
+			return err
+		}
+
+		resolved, err := resolveReports(tx, review.ID)
+		if err != nil {
+			return err
+		}
+
+		if !changed && resolved == 0 {
+			return nil
+		}
+
+		return tx.Create(entry).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, ErrNotFound
+		}
+
+		r.logger.Error("failed restore review",
+			zap.Any("entry", entry),
+			zap.Error(err))
+
+		return nil, false, ErrInternal
+	}
+
+	return &review, changed, nil
+}
+
+// ResolveReports closes the open reports of the review without touching
+// the review and returns how many it closed.
+func (r *Repository) ResolveReports(ctx context.Context, entry *entity.ModerationLog) (int64, error) {
+	r.logger.Info("resolving reports",
+		zap.Any("entry", entry))
+
+	var resolved int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").First(&entity.Review{}, entry.ReviewID).Error; err != nil {
+			return err
+		}
+
+		var err error
+
+		if resolved, err = resolveReports(tx, entry.ReviewID); err != nil || resolved == 0 {
+			return err
+		}
+
+		return tx.Create(entry).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, ErrNotFound
+		}
+
+		r.logger.Error("failed resolve reports",
+			zap.Any("entry", entry),
+			zap.Error(err))
+
+		return 0, ErrInternal
+	}
+
+	return resolved, nil
+}
+
+// ListModerationQueue returns up to limit reviews with open reports, hidden
+// ones included, in the order of their first open report, starting right
+// after the cursor when one is given.
+func (r *Repository) ListModerationQueue(ctx context.Context, after *entity.ModerationCursor, limit int) ([]entity.ModerationItem, error) {
+	r.logger.Info("listing moderation queue...",
+		zap.Any("after", after),
+		zap.Int("limit", limit))
+
+	var heads []struct {
+		ReviewID      uint
+		FirstReportID uint
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&entity.ReviewReport{}).
+		Select("review_id, MIN(id) AS first_report_id").
+		Where("resolved_at IS NULL").
+		Group("review_id")
+
+	if after != nil {
+		query = query.Having("MIN(id) > ?", after.FirstReportID)
+	}
+
+	err := query.
+		Order("first_report_id").
+		Limit(limit).
+		Scan(&heads).Error
+	if err != nil {
+		r.logger.Error("failed list moderation queue",
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	if len(heads) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint, len(heads))
+	for i, head := range heads {
+		ids[i] = head.ReviewID
+	}
+
+	var (
+		reviews []entity.Review
+		reports []entity.ReviewReport
+	)
+
+	err = r.db.WithContext(ctx).Find(&reviews, ids).Error
+	if err == nil {
+		err = r.db.WithContext(ctx).
+			Where("review_id IN ? AND resolved_at IS NULL", ids).
+			Order("id").
+			Find(&reports).Error
+	}
+	if err != nil {
+		r.logger.Error("failed fetch reported reviews",
+			zap.Uints("review_ids", ids),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	items := make(map[uint]*entity.ModerationItem, len(reviews))
+	for _, review := range reviews {
+		items[review.ID] = &entity.ModerationItem{
+			Review:  review,
+			Reasons: make(map[entity.ReportReason]int64),
+		}
+	}
+
+	for _, report := range reports {
+		item := items[report.ReviewID]
+		if item == nil {
+			continue
+		}
+
+		if item.Reports == 0 {
+			item.FirstReported = report.CreatedAt
+		}
+
+		item.Reports++
+		item.Reasons[report.Reason]++
+	}
+
+	queue := make([]entity.ModerationItem, 0, len(heads))
+
+	for _, head := range heads {
+		// the review may have been deleted between the queries
+		if item := items[head.ReviewID]; item != nil && item.Reports > 0 {
+			item.FirstReportID = head.FirstReportID
+			queue = append(queue, *item)
+		}
+	}
+
+	return queue, nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveReleaseParent records where a release belongs in the catalog and
+// replaces the metadata kept for it.
+func (r *Repository) SaveReleaseParent(ctx context.Context, parent *entity.ReleaseParent) error {
+	r.logger.Info("saving release parent",
+		zap.Any("parent", parent))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "release_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"release_group_id", "artist_id", "year", "country", "primary_type", "described"}),
+		}).Create(parent).Error
+		if err != nil {
+			return err
+		}
+
+		if err = tx.Where("release_id = ?", parent.ReleaseID).Delete(&entity.ReleaseGenre{}).Error; err != nil {
+			return err
+		}
+
+		if len(parent.Genres) == 0 {
+			return nil
+		}
+
+		genres := make([]entity.ReleaseGenre, len(parent.Genres))
+		for i, genre := range parent.Genres {
+			genres[i] = entity.ReleaseGenre{
+				ReleaseID: parent.ReleaseID,
+				Genre:     genre,
+			}
+		}
+
+		return tx.Create(&genres).Error
+	})
+	if err != nil {
+		r.logger.Error("failed save release parent",
+			zap.Any("parent", parent),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ListReleaseParents returns the parents known for the given releases.
+func (r *Repository) ListReleaseParents(ctx context.Context, releaseIDs []string) ([]entity.ReleaseParent, error) {
+	var parents []entity.ReleaseParent
+
+	err := r.db.WithContext(ctx).
+		Where("release_id IN ?", releaseIDs).
+		Find(&parents).Error
+	if err != nil {
+		r.logger.Error("failed fetch release parents",
+			zap.Strings("release_ids", releaseIDs),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return parents, nil
+}
+
+// ListUnplacedReleases returns the reviewed or logged releases with no
+// known parents or no metadata.
+func (r *Repository) ListUnplacedReleases(ctx context.Context) ([]string, error) {
+	var releaseIDs []string
+
+	listened := r.db.Raw("SELECT release_id FROM reviews UNION SELECT release_id FROM diary_entries")
+
+	err := r.db.WithContext(ctx).
+		Table("(?) AS listened", listened).
+		Where("release_id NOT IN (?)", r.db.Model(&entity.ReleaseParent{}).Where("described = ?", true).Select("release_id")).
+		Pluck("release_id", &releaseIDs).Error
+	if err != nil {
+		r.logger.Error("failed fetch unplaced releases",
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return releaseIDs, nil
+}
+
+// GetReleaseGroupMark sums up the marks of all releases of a release group.
+func (r *Repository) GetReleaseGroupMark(ctx context.Context, releaseGroupID string, minVotes int) (*entity.Mark, error) {
+	return r.sumMarks(ctx, "release_group_id", releaseGroupID, minVotes)
+}
+
+// GetArtistMark sums up the marks of all releases of an artist.
+func (r *Repository) GetArtistMark(ctx context.Context, artistID string, minVotes int) (*entity.Mark, error) {
+	return r.sumMarks(ctx, "artist_id", artistID, minVotes)
+}
+
+// sumMarks adds up the histograms of the releases whose parent column
+// matches id and weighs the sum against the mean of all reviews. The result
+// has no id or release of its own.
+func (r *Repository) sumMarks(ctx context.Context, column, id string, minVotes int) (*entity.Mark, error) {
+	r.logger.Info("summing marks",
+		zap.String(column, id))
+
+	var marks []entity.Mark
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN release_parents ON release_parents.release_id = marks.release_id").
+		Where("release_parents."+column+" = ?", id).
+		Find(&marks).Error
+
+	var mean float64
+	if err == nil {
+		mean, err = globalMean(r.db.WithContext(ctx))
+	}
+	if err != nil {
+		r.logger.Error("failed sum marks",
+			zap.String(column, id),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	sum := entity.NewMark("")
+
+	for _, mark := range marks {
+		sum.Apply(mark.Histogram)
+
+		if mark.UpdatedAt.After(sum.UpdatedAt) {
+			sum.UpdatedAt = mark.UpdatedAt
+		}
+	}
+
+	sum.Weigh(mean, minVotes)
+
+	return sum, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/mark/entity"
@@ -74,47 +75,135 @@ func (r *Repository) UpdateReview(ctx context.Context, id uint, update *entity.R
 	return nil
 }
 
-func (r *Repository) DeleteReview(ctx context.Context, id uint) error {
+// DeleteReview removes the review with its likes, comments and revisions
+// and returns the number of likes it had.
+func (r *Repository) DeleteReview(ctx context.Context, id uint) (int64, error) {
 	r.logger.Info("deleting review",
 		zap.Uint("id", id))
 
-	res := r.db.WithContext(ctx).Delete(&entity.Review{}, id)
-	if err := res.Error; err != nil {
+	var likes int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var review entity.Review
+
+		if err := tx.First(&review, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+
+			return err
+		}
+
+		if err := tx.Delete(&review).Error; err != nil {
+			return err
+		}
+
+		res := tx.Where("review_id = ?", id).Delete(&entity.ReviewLike{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		likes = res.RowsAffected
+
+		if err := tx.Where("review_id = ?", id).Delete(&entity.Comment{}).Error; err != nil {
+			return err
+		}
+
+		// reports are kept for audit but leave the moderation queue
+		if _, err := resolveReports(tx, id); err != nil {
+			return err
+		}
+
+		// diary entries outlive the review they link to
+		err := tx.Model(&entity.DiaryEntry{}).
+			Where("review_id = ?", id).
+			UpdateColumn("review_id", nil).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("review_id = ?", id).Delete(&entity.ReviewRevision{}).Error
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return 0, ErrNotFound
+		}
+
 		r.logger.Error("failed delete review by release_id",
 			zap.Uint("id", id),
 			zap.Error(err))
 
-		if res.RowsAffected == 0 {
-			return ErrNotFound
-		}
-
-		return ErrInternal
+		return 0, ErrInternal
 	}
 
 	r.logger.Info("review delete",
 		zap.Uint("id", id))
 
-	return nil
+	return likes, nil
 }
 
-func (r *Repository) GetReviewsByReleaseID(ctx context.Context, releaseID string) ([]entity.Review, error) {
-	r.logger.Info("fetching reviews by release id",
-		zap.String("id", releaseID))
+// ListReviews returns up to limit reviews of a release matching the filter
+// in its order, starting right after the cursor when one is given.
+func (r *Repository) ListReviews(ctx context.Context, filter entity.ReviewFilter, after *entity.ReviewCursor, limit int) ([]entity.Review, error) {
+	r.logger.Info("listing reviews...",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	column := filter.OrderBy.Column()
+
+	dir, cmp := "ASC", ">"
+	if filter.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&entity.Review{}).
+		Where("release_id = ? AND hidden_at IS NULL", filter.ReleaseID).
+		Where("count BETWEEN ? AND ?", filter.MinScore, filter.MaxScore)
+
+	switch filter.Kind {
+	case entity.ReviewKindText:
+		query = query.Where("TRIM(text) <> ''")
+	case entity.ReviewKindScore:
+		query = query.Where("TRIM(text) = ''")
+	}
+
+	if filter.Language != "" {
+		query = query.Where("language = ?", filter.Language)
+	}
+
+	if after != nil {
+		var value any
+
+		switch filter.OrderBy {
+		case entity.ReviewOrderLikes:
+			value = after.Likes
+		case entity.ReviewOrderScore:
+			value = after.Count
+		default:
+			value = after.CreatedAt
+		}
+
+		query = query.Where(
+			fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", column, cmp),
+			value, value, after.ID,
+		)
+	}
 
 	var reviews []entity.Review
-	res := r.db.WithContext(ctx).Where("release_id = ?", releaseID).Find(&reviews)
 
-	if err := res.Error; err != nil {
-		r.logger.Error("failed fetch release id",
-			zap.String("release_id", releaseID),
+	err := query.
+		Order(fmt.Sprintf("%s %s, id %s", column, dir, dir)).
+		Limit(limit).
+		Find(&reviews).Error
+	if err != nil {
+		r.logger.Error("failed list reviews",
+			zap.Any("filter", filter),
 			zap.Error(err))
 
 		return nil, ErrInternal
 	}
 
-	r.logger.Info("reviews successfully fetched",
-		zap.Int("len", len(reviews)))
-
 	return reviews, nil
 }
 
@@ -123,7 +212,7 @@ func (r *Repository) GetReviewByID(ctx context.Context, id uint) (*entity.Review
 		zap.Uint("id", id))
 
 	var review entity.Review
-	res := r.db.WithContext(ctx).First(&review, id)
+	res := r.db.WithContext(ctx).Where("hidden_at IS NULL").First(&review, id)
 	if err := res.Error; err != nil {
 		r.logger.Error("failed fetch review",
 			zap.Uint("id", id),
@@ -142,6 +231,54 @@ func (r *Repository) GetReviewByID(ctx context.Context, id uint) (*entity.Review
 	return &review, nil
 }
 
+// GetAnyReviewByID is GetReviewByID including reviews hidden by moderators.
+func (r *Repository) GetAnyReviewByID(ctx context.Context, id uint) (*entity.Review, error) {
+	r.logger.Info("fetching review",
+		zap.Uint("id", id))
+
+	var review entity.Review
+	if err := r.db.WithContext(ctx).First(&review, id).Error; err != nil {
+		r.logger.Error("failed fetch review",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &review, nil
+}
+
+// GetUserReview returns the review the user keeps for the release.
+func (r *Repository) GetUserReview(ctx context.Context, userID, releaseID string) (*entity.Review, error) {
+	r.logger.Info("fetching user review",
+		zap.String("user_id", userID),
+		zap.String("release_id", releaseID))
+
+	var review entity.Review
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND release_id = ?", userID, releaseID).
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		r.logger.Error("failed fetch user review",
+			zap.String("user_id", userID),
+			zap.String("release_id", releaseID),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &review, nil
+}
+
 func (r *Repository) GetMarkByReleaseID(ctx context.Context, releaseID string) (*entity.Mark, error) {
 	r.logger.Info("fetching mark",
 		zap.String("release_id", releaseID))
@@ -165,26 +302,3 @@ func (r *Repository) GetMarkByReleaseID(ctx context.Context, releaseID string) (
 
 	return &mark, nil
 }
-
-func (r *Repository) UpdateMarkByReleaseID(ctx context.Context, releaseID string, update *entity.Mark) error {
-	r.logger.Info("updating mark",
-		zap.String("release_id", releaseID),
-		zap.Any("update", update))
-
-	res := r.db.WithContext(ctx).Save(&update)
-
-	if err := res.Error; err != nil {
-		r.logger.Error("failed update mark",
-			zap.String("release_id", releaseID),
-			zap.Any("update", update),
-			zap.Error(err))
-
-		return ErrInternal
-	}
-
-	r.logger.Info("successfully updated mark",
-		zap.String("release_id", releaseID),
-		zap.Any("update", update))
-
-	return nil
-}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// EditReview stores the new text and score of the review together with
+// the revision it replaces. Likes are left alone so that concurrent likes
+// are not overwritten.
+func (r *Repository) EditReview(ctx context.Context, review *entity.Review, revision *entity.ReviewRevision) error {
+	r.logger.Info("editing review",
+		zap.Uint("id", review.ID))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(review).Select("text", "count", "language", "edited_at").Updates(review).Error
+	})
+	if err != nil {
+		r.logger.Error("failed edit review",
+			zap.Uint("id", review.ID),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	r.logger.Info("review edited successfully",
+		zap.Uint("id", review.ID))
+
+	return nil
+}
+
+// ListReviewRevisions returns the earlier versions of a review, newest
+// first.
+func (r *Repository) ListReviewRevisions(ctx context.Context, reviewID uint) ([]entity.ReviewRevision, error) {
+	r.logger.Info("fetching review revisions",
+		zap.Uint("review_id", reviewID))
+
+	var revisions []entity.ReviewRevision
+
+	err := r.db.WithContext(ctx).
+		Where("review_id = ?", reviewID).
+		Order("written_at DESC, id DESC").
+		Find(&revisions).Error
+	if err != nil {
+		r.logger.Error("failed fetch review revisions",
+			zap.Uint("review_id", reviewID),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return revisions, nil
+}
+
+// MergeDuplicateReviews keeps the newest review of every user and release
+// and turns the older ones into its revisions, adding up their likes. It runs before the unique
+// index on reviews is created, which would fail on duplicates. The number
+// of reviews merged away is returned by user.
+func (r *Repository) MergeDuplicateReviews(ctx context.Context) (map[string]int, error) {
+	r.logger.Info("merging duplicate reviews")
+
+	var duplicates []struct {
+		UserID    string
+		ReleaseID string
+	}
+
+	err := r.db.WithContext(ctx).
+		Table("reviews").
+		Select("user_id, release_id").
+		Group("user_id, release_id").
+		Having("COUNT(*) > 1").
+		Scan(&duplicates).Error
+	if err != nil {
+		r.logger.Error("failed find duplicate reviews",
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	merged := make(map[string]int)
+
+	for _, dup := range duplicates {
+		removed := 0
+
+		err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var reviews []entity.Review
+
+			err := tx.
+				Where("user_id = ? AND release_id = ?", dup.UserID, dup.ReleaseID).
+				Order("created_at DESC, id DESC").
+				Find(&reviews).Error
+			if err != nil {
+				return err
+			}
+
+			kept := reviews[0]
+			likes := kept.Likes
+			removed = len(reviews) - 1
+
+			for _, old := range reviews[1:] {
+				likes += old.Likes
+
+				revision := entity.NewReviewRevision(&old)
+				revision.ReviewID = kept.ID
+
+				if err = tx.Create(revision).Error; err != nil {
+					return err
+				}
+
+				if err = tx.Delete(&entity.Review{}, old.ID).Error; err != nil {
+					return err
+				}
+			}
+
+			return tx.Model(&kept).UpdateColumn("likes", likes).Error
+		})
+		if err != nil {
+			r.logger.Error("failed merge duplicate reviews",
+				zap.String("user_id", dup.UserID),
+				zap.String("release_id", dup.ReleaseID),
+				zap.Error(err))
+
+			return nil, ErrInternal
+		}
+
+		merged[dup.UserID] += removed
+	}
+
+	r.logger.Info("duplicate reviews merged",
+		zap.Int("groups", len(duplicates)))
+
+	return merged, nil
+}
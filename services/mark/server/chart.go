@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/core"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetChart(ctx context.Context, req *pb.GetChartRequest) (*pb.GetChartResponse, error) {
+	metrics.RequestTotal.WithLabelValues("GetChart").Inc()
+	then := time.Now()
+
+	s.logger.Info("new get chart request",
+		zap.Any("req", req))
+
+	chart, err := core.ParseChart(req.Period, int(req.Year))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	filter := entity.ChartFilter{
+		Genre:       req.Genre,
+		Country:     req.Country,
+		PrimaryType: req.PrimaryType,
+	}
+
+	page, err := s.core.GetChart(chart, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	entries := make([]*pb.ChartEntry, len(page.Entries))
+	for i, entry := range page.Entries {
+		entries[i] = entry.ToPB()
+	}
+
+	resp := &pb.GetChartResponse{
+		Entries:       entries,
+		NextPageToken: page.NextPageToken,
+	}
+
+	if !page.ComputedAt.IsZero() {
+		resp.ComputedAt = timestamppb.New(page.ComputedAt)
+	}
+
+	metrics.RequestDuration.WithLabelValues("GetChart").Observe(time.Since(then).Seconds())
+
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.Comment, error) {
+	metrics.RequestTotal.WithLabelValues("CreateComment").Inc()
+	then := time.Now()
+
+	s.logger.Info("new create comment request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if !claims.EmailVerified {
+		return nil, status.Error(codes.PermissionDenied, "email is not verified")
+	}
+
+	comment, err := s.core.CreateComment(uint(req.ReviewId), uint(req.ParentId), claims.UserID, req.Text)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("CreateComment").Observe(time.Since(then).Seconds())
+
+	return comment.ToPB(), nil
+}
+
+func (s *Server) EditComment(ctx context.Context, req *pb.EditCommentRequest) (*pb.Comment, error) {
+	metrics.RequestTotal.WithLabelValues("EditComment").Inc()
+	then := time.Now()
+
+	s.logger.Info("new edit comment request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	comment, err := s.core.EditComment(uint(req.Id), claims.UserID, req.Text)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("EditComment").Observe(time.Since(then).Seconds())
+
+	return comment.ToPB(), nil
+}
+
+// DeleteComment lets moderators remove any comment, the same as reviews.
+func (s *Server) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*emptypb.Empty, error) {
+	metrics.RequestTotal.WithLabelValues("DeleteComment").Inc()
+	then := time.Now()
+
+	s.logger.Info("new delete comment request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	err := s.core.DeleteComment(uint(req.Id), claims.UserID, claims.Role.Can(authz.PermDeleteAnyReview))
+	if err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("DeleteComment").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ListComments").Inc()
+	then := time.Now()
+
+	s.logger.Info("new list comments request",
+		zap.Any("req", req))
+
+	depth := -1
+	if req.Depth != nil {
+		depth = int(*req.Depth)
+	}
+
+	filter := entity.CommentFilter{
+		ReviewID: uint(req.ReviewId),
+		ParentID: uint(req.ParentId),
+	}
+
+	comments, next, err := s.core.ListComments(filter, depth, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	pbcomments := make([]*pb.Comment, len(comments))
+	for i, comment := range comments {
+		pbcomments[i] = comment.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListComments").Observe(time.Since(then).Seconds())
+
+	return &pb.ListCommentsResponse{
+		Comments:      pbcomments,
+		NextPageToken: next,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// diaryScore converts an optional proto score.
+func diaryScore(score *int32) *int {
+	if score == nil {
+		return nil
+	}
+
+	value := int(*score)
+
+	return &value
+}
+
+// diaryOwner is the user whose diary is read, the caller when unset.
+func diaryOwner(ctx context.Context, userID string) (string, error) {
+	if len(userID) > 0 {
+		return userID, nil
+	}
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	return claims.UserID, nil
+}
+
+func (s *Server) CreateDiaryEntry(ctx context.Context, req *pb.CreateDiaryEntryRequest) (*pb.DiaryEntry, error) {
+	metrics.RequestTotal.WithLabelValues("CreateDiaryEntry").Inc()
+	then := time.Now()
+
+	s.logger.Info("new create diary entry request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	entry, err := s.core.CreateDiaryEntry(claims.UserID, req.ReleaseId, req.ListenedOn,
+		diaryScore(req.Score), uint(req.ReviewId), req.Relisten)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("CreateDiaryEntry").Observe(time.Since(then).Seconds())
+
+	return entry.ToPB(), nil
+}
+
+func (s *Server) EditDiaryEntry(ctx context.Context, req *pb.EditDiaryEntryRequest) (*pb.DiaryEntry, error) {
+	metrics.RequestTotal.WithLabelValues("EditDiaryEntry").Inc()
+	then := time.Now()
+
+	s.logger.Info("new edit diary entry request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	entry, err := s.core.EditDiaryEntry(uint(req.Id), claims.UserID, req.ListenedOn,
+		diaryScore(req.Score), uint(req.ReviewId), req.Relisten)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("EditDiaryEntry").Observe(time.Since(then).Seconds())
+
+	return entry.ToPB(), nil
+}
+
+func (s *Server) DeleteDiaryEntry(ctx context.Context, req *pb.DeleteDiaryEntryRequest) (*emptypb.Empty, error) {
+	metrics.RequestTotal.WithLabelValues("DeleteDiaryEntry").Inc()
+	then := time.Now()
+
+	s.logger.Info("new delete diary entry request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if err := s.core.DeleteDiaryEntry(uint(req.Id), claims.UserID); err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("DeleteDiaryEntry").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListDiary(ctx context.Context, req *pb.ListDiaryRequest) (*pb.ListDiaryResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ListDiary").Inc()
+	then := time.Now()
+
+	s.logger.Info("new list diary request",
+		zap.Any("req", req))
+
+	userID, err := diaryOwner(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := entity.DiaryFilter{
+		UserID: userID,
+		Year:   int(req.Year),
+		Month:  int(req.Month),
+	}
+
+	entries, next, err := s.core.ListDiary(filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	resp := &pb.ListDiaryResponse{
+		Entries:       make([]*pb.DiaryEntry, len(entries)),
+		NextPageToken: next,
+	}
+
+	for i, entry := range entries {
+		resp.Entries[i] = entry.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListDiary").Observe(time.Since(then).Seconds())
+
+	return resp, nil
+}
+
+func (s *Server) GetDiaryStats(ctx context.Context, req *pb.GetDiaryStatsRequest) (*pb.DiaryStats, error) {
+	metrics.RequestTotal.WithLabelValues("GetDiaryStats").Inc()
+	then := time.Now()
+
+	s.logger.Info("new get diary stats request",
+		zap.Any("req", req))
+
+	userID, err := diaryOwner(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.core.GetDiaryStats(userID, int(req.Year))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("GetDiaryStats").Observe(time.Since(then).Seconds())
+
+	return stats.ToPB(), nil
+}
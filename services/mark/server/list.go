@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// viewer is the caller reading lists, empty for anonymous callers who see
+// public lists only.
+func viewer(ctx context.Context) string {
+	if claims, ok := authz.FromContext(ctx); ok {
+		return claims.UserID
+	}
+
+	return ""
+}
+
+func (s *Server) CreateList(ctx context.Context, req *pb.CreateListRequest) (*pb.List, error) {
+	metrics.RequestTotal.WithLabelValues("CreateList").Inc()
+	then := time.Now()
+
+	s.logger.Info("new create list request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if !claims.EmailVerified {
+		return nil, status.Error(codes.PermissionDenied, "email is not verified")
+	}
+
+	list, err := s.core.CreateList(claims.UserID, req.Name, req.Description, req.Public)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("CreateList").Observe(time.Since(then).Seconds())
+
+	return list.ToPB(), nil
+}
+
+func (s *Server) UpdateList(ctx context.Context, req *pb.UpdateListRequest) (*pb.List, error) {
+	metrics.RequestTotal.WithLabelValues("UpdateList").Inc()
+	then := time.Now()
+
+	s.logger.Info("new update list request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	list, err := s.core.UpdateList(uint(req.Id), claims.UserID, req.Name, req.Description, req.Public)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("UpdateList").Observe(time.Since(then).Seconds())
+
+	return list.ToPB(), nil
+}
+
+// DeleteList lets moderators remove any public list, the same as reviews.
+func (s *Server) DeleteList(ctx context.Context, req *pb.DeleteListRequest) (*emptypb.Empty, error) {
+	metrics.RequestTotal.WithLabelValues("DeleteList").Inc()
+	then := time.Now()
+
+	s.logger.Info("new delete list request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	err := s.core.DeleteList(uint(req.Id), claims.UserID, claims.Role.Can(authz.PermDeleteAnyReview))
+	if err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("DeleteList").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetList(ctx context.Context, req *pb.GetListRequest) (*pb.List, error) {
+	metrics.RequestTotal.WithLabelValues("GetList").Inc()
+	then := time.Now()
+
+	s.logger.Info("new get list request",
+		zap.Any("req", req))
+
+	list, err := s.core.GetList(uint(req.Id), viewer(ctx))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("GetList").Observe(time.Since(then).Seconds())
+
+	return list.ToPB(), nil
+}
+
+func (s *Server) BrowseLists(ctx context.Context, req *pb.BrowseListsRequest) (*pb.BrowseListsResponse, error) {
+	metrics.RequestTotal.WithLabelValues("BrowseLists").Inc()
+	then := time.Now()
+
+	s.logger.Info("new browse lists request",
+		zap.Any("req", req))
+
+	filter := entity.ListFilter{
+		UserID:    req.UserId,
+		ReleaseID: req.ReleaseId,
+		OrderBy:   entity.ListOrder(req.OrderBy),
+	}
+
+	lists, next, err := s.core.BrowseLists(filter, viewer(ctx), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	resp := &pb.BrowseListsResponse{
+		Lists:         make([]*pb.List, len(lists)),
+		NextPageToken: next,
+	}
+
+	for i, list := range lists {
+		resp.Lists[i] = list.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("BrowseLists").Observe(time.Since(then).Seconds())
+
+	return resp, nil
+}
+
+func (s *Server) AddListItem(ctx context.Context, req *pb.AddListItemRequest) (*pb.ListItem, error) {
+	metrics.RequestTotal.WithLabelValues("AddListItem").Inc()
+	then := time.Now()
+
+	s.logger.Info("new add list item request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	item, err := s.core.AddListItem(uint(req.ListId), claims.UserID, req.ReleaseId, req.Note, int(req.Position))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("AddListItem").Observe(time.Since(then).Seconds())
+
+	return item.ToPB(), nil
+}
+
+func (s *Server) EditListItem(ctx context.Context, req *pb.EditListItemRequest) (*pb.ListItem, error) {
+	metrics.RequestTotal.WithLabelValues("EditListItem").Inc()
+	then := time.Now()
+
+	s.logger.Info("new edit list item request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	item, err := s.core.EditListItem(uint(req.Id), claims.UserID, req.Note)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("EditListItem").Observe(time.Since(then).Seconds())
+
+	return item.ToPB(), nil
+}
+
+func (s *Server) MoveListItem(ctx context.Context, req *pb.MoveListItemRequest) (*pb.ListItem, error) {
+	metrics.RequestTotal.WithLabelValues("MoveListItem").Inc()
+	then := time.Now()
+
+	s.logger.Info("new move list item request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	item, err := s.core.MoveListItem(uint(req.Id), claims.UserID, int(req.Position))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("MoveListItem").Observe(time.Since(then).Seconds())
+
+	return item.ToPB(), nil
+}
+
+func (s *Server) RemoveListItem(ctx context.Context, req *pb.RemoveListItemRequest) (*emptypb.Empty, error) {
+	metrics.RequestTotal.WithLabelValues("RemoveListItem").Inc()
+	then := time.Now()
+
+	s.logger.Info("new remove list item request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if err := s.core.RemoveListItem(uint(req.Id), claims.UserID); err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("RemoveListItem").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetListItems(ctx context.Context, req *pb.GetListItemsRequest) (*pb.GetListItemsResponse, error) {
+	metrics.RequestTotal.WithLabelValues("GetListItems").Inc()
+	then := time.Now()
+
+	s.logger.Info("new get list items request",
+		zap.Any("req", req))
+
+	items, next, err := s.core.GetListItems(uint(req.ListId), viewer(ctx), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	resp := &pb.GetListItemsResponse{
+		Items:         make([]*pb.ListItem, len(items)),
+		NextPageToken: next,
+	}
+
+	for i, item := range items {
+		resp.Items[i] = item.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("GetListItems").Observe(time.Since(then).Seconds())
+
+	return resp, nil
+}
+
+func (s *Server) LikeList(ctx context.Context, req *pb.LikeListRequest) (*pb.LikeListResponse, error) {
+	metrics.RequestTotal.WithLabelValues("LikeList").Inc()
+	then := time.Now()
+
+	s.logger.Info("new like list request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	likes, err := s.core.LikeList(uint(req.ListId), claims.UserID)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("LikeList").Observe(time.Since(then).Seconds())
+
+	return &pb.LikeListResponse{
+		Likes: likes,
+	}, nil
+}
+
+func (s *Server) UnlikeList(ctx context.Context, req *pb.UnlikeListRequest) (*pb.UnlikeListResponse, error) {
+	metrics.RequestTotal.WithLabelValues("UnlikeList").Inc()
+	then := time.Now()
+
+	s.logger.Info("new unlike list request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	likes, err := s.core.UnlikeList(uint(req.ListId), claims.UserID)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("UnlikeList").Observe(time.Since(then).Seconds())
+
+	return &pb.UnlikeListResponse{
+		Likes: likes,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
+	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) ReportReview(ctx context.Context, req *pb.ReportReviewRequest) (*emptypb.Empty, error) {
+	metrics.RequestTotal.WithLabelValues("ReportReview").Inc()
+	then := time.Now()
+
+	s.logger.Info("new report review request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	// reports of distinct users hide reviews, unverified accounts are too
+	// cheap to count
+	if !claims.EmailVerified {
+		return &emptypb.Empty{}, status.Error(codes.PermissionDenied, "email is not verified")
+	}
+
+	err := s.core.ReportReview(uint(req.ReviewId), claims.UserID, entity.ReportReason(req.Reason), req.Details)
+	if err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("ReportReview").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationQueueResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ListModerationQueue").Inc()
+	then := time.Now()
+
+	s.logger.Info("new list moderation queue request",
+		zap.Any("req", req))
+
+	items, next, err := s.core.ListModerationQueue(int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	pbitems := make([]*pb.ModerationItem, len(items))
+	for i, item := range items {
+		pbitems[i] = item.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListModerationQueue").Observe(time.Since(then).Seconds())
+
+	return &pb.ListModerationQueueResponse{
+		Items:         pbitems,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *Server) HideReview(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.Review, error) {
+	metrics.RequestTotal.WithLabelValues("HideReview").Inc()
+	then := time.Now()
+
+	s.logger.Info("new hide review request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	review, err := s.core.HideReview(uint(req.ReviewId), claims.UserID, req.Note)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("HideReview").Observe(time.Since(then).Seconds())
+
+	return review.ToPB(), nil
+}
+
+func (s *Server) RestoreReview(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.Review, error) {
+	metrics.RequestTotal.WithLabelValues("RestoreReview").Inc()
+	then := time.Now()
+
+	s.logger.Info("new restore review request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	review, err := s.core.RestoreReview(uint(req.ReviewId), claims.UserID, req.Note)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("RestoreReview").Observe(time.Since(then).Seconds())
+
+	return review.ToPB(), nil
+}
+
+func (s *Server) ResolveReports(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.ResolveReportsResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ResolveReports").Inc()
+	then := time.Now()
+
+	s.logger.Info("new resolve reports request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	resolved, err := s.core.ResolveReports(uint(req.ReviewId), claims.UserID, req.Note)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("ResolveReports").Observe(time.Since(then).Seconds())
+
+	return &pb.ResolveReportsResponse{
+		Resolved: resolved,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
+)
+
+// Policy lists the MarkService methods that need an authenticated caller
+// and the permissions some of them need on top.
+var Policy = authz.Policy{
+	pb.MarkService_CreateReview_FullMethodName: authz.Authenticated,
+	pb.MarkService_DeleteReview_FullMethodName: authz.Authenticated,
+	pb.MarkService_UpdateReview_FullMethodName: authz.Authenticated,
+	pb.MarkService_Rate_FullMethodName:         authz.Authenticated,
+	pb.MarkService_LikeReview_FullMethodName:   authz.Authenticated,
+	pb.MarkService_UnlikeReview_FullMethodName: authz.Authenticated,
+
+	pb.MarkService_CreateComment_FullMethodName: authz.Authenticated,
+	pb.MarkService_EditComment_FullMethodName:   authz.Authenticated,
+	pb.MarkService_DeleteComment_FullMethodName: authz.Authenticated,
+
+	pb.MarkService_ReportReview_FullMethodName:        authz.Authenticated,
+	pb.MarkService_ListModerationQueue_FullMethodName: authz.PermModerateReviews,
+	pb.MarkService_HideReview_FullMethodName:          authz.PermModerateReviews,
+	pb.MarkService_RestoreReview_FullMethodName:       authz.PermModerateReviews,
+	pb.MarkService_ResolveReports_FullMethodName:      authz.PermModerateReviews,
+
+	pb.MarkService_CreateDiaryEntry_FullMethodName: authz.Authenticated,
+	pb.MarkService_EditDiaryEntry_FullMethodName:   authz.Authenticated,
+	pb.MarkService_DeleteDiaryEntry_FullMethodName: authz.Authenticated,
+
+	pb.MarkService_CreateList_FullMethodName:     authz.Authenticated,
+	pb.MarkService_UpdateList_FullMethodName:     authz.Authenticated,
+	pb.MarkService_DeleteList_FullMethodName:     authz.Authenticated,
+	pb.MarkService_AddListItem_FullMethodName:    authz.Authenticated,
+	pb.MarkService_EditListItem_FullMethodName:   authz.Authenticated,
+	pb.MarkService_MoveListItem_FullMethodName:   authz.Authenticated,
+	pb.MarkService_RemoveListItem_FullMethodName: authz.Authenticated,
+	pb.MarkService_LikeList_FullMethodName:       authz.Authenticated,
+	pb.MarkService_UnlikeList_FullMethodName:     authz.Authenticated,
+}
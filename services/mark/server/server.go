@@ -2,13 +2,19 @@ package server
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/mark/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/mark/core"
+	"github.com/osamikoyo/music-and-marks/services/mark/entity"
 	"github.com/osamikoyo/music-and-marks/services/mark/metrics"
+	"github.com/osamikoyo/music-and-marks/services/mark/repository"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -25,6 +31,38 @@ func NewServer(core *core.Core, logger *logger.Logger) *Server {
 	}
 }
 
+func reviewStatus(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrForbidden), errors.Is(err, core.ErrCommentForbidden),
+		errors.Is(err, core.ErrDiaryForbidden), errors.Is(err, core.ErrListForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, core.ErrReviewExists), errors.Is(err, core.ErrAlreadyReported),
+		errors.Is(err, core.ErrReleaseListed):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, core.ErrOwnReview), errors.Is(err, core.ErrInvalidPageToken),
+		errors.Is(err, core.ErrInvalidComment), errors.Is(err, core.ErrInvalidParent),
+		errors.Is(err, core.ErrInvalidScore), errors.Is(err, core.ErrInvalidOrder),
+		errors.Is(err, core.ErrEmptyCatalogID), errors.Is(err, core.ErrInvalidKind),
+		errors.Is(err, core.ErrInvalidRange), errors.Is(err, core.ErrInvalidLanguage),
+		errors.Is(err, core.ErrInvalidReason), errors.Is(err, core.ErrInvalidDetails),
+		errors.Is(err, core.ErrReportOwnReview), errors.Is(err, core.ErrInvalidChart),
+		errors.Is(err, core.ErrInvalidCountry), errors.Is(err, core.ErrEmptyReleaseID),
+		errors.Is(err, core.ErrInvalidDate), errors.Is(err, core.ErrInvalidPeriod),
+		errors.Is(err, core.ErrInvalidDiaryReview), errors.Is(err, core.ErrInvalidListName),
+		errors.Is(err, core.ErrInvalidListText), errors.Is(err, core.ErrInvalidPosition),
+		errors.Is(err, core.ErrOwnList), errors.Is(err, core.ErrUnknownRelease),
+		errors.Is(err, core.ErrInvalidListOrder):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, core.ErrThreadTooDeep), errors.Is(err, core.ErrReviewHidden),
+		errors.Is(err, core.ErrListFull):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
 func (s *Server) CreateReview(ctx context.Context, req *pb.Review) (*emptypb.Empty, error) {
 	metrics.RequestTotal.WithLabelValues("CreateReview").Inc()
 	then := time.Now()
@@ -32,8 +70,17 @@ func (s *Server) CreateReview(ctx context.Context, req *pb.Review) (*emptypb.Emp
 	s.logger.Info("new create review request",
 		zap.Any("req", req))
 
-	if err := s.core.CreateReview(req.ReleaseId, req.Text, req.UserId, int(req.Count)); err != nil {
-		return &emptypb.Empty{}, err
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if !claims.EmailVerified {
+		return &emptypb.Empty{}, status.Error(codes.PermissionDenied, "email is not verified")
+	}
+
+	if err := s.core.CreateReview(req.ReleaseId, req.Text, claims.UserID, req.Language, int(req.Count)); err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
 	}
 
 	metrics.RequestDuration.WithLabelValues("CreateReview").Observe(time.Since(then).Seconds())
@@ -41,6 +88,78 @@ func (s *Server) CreateReview(ctx context.Context, req *pb.Review) (*emptypb.Emp
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Server) Rate(ctx context.Context, req *pb.RateRequest) (*pb.Review, error) {
+	metrics.RequestTotal.WithLabelValues("Rate").Inc()
+	then := time.Now()
+
+	s.logger.Info("new rate request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if !claims.EmailVerified {
+		return nil, status.Error(codes.PermissionDenied, "email is not verified")
+	}
+
+	review, err := s.core.Rate(req.ReleaseId, claims.UserID, int(req.Score))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("Rate").Observe(time.Since(then).Seconds())
+
+	return review.ToPB(), nil
+}
+
+func (s *Server) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.Review, error) {
+	metrics.RequestTotal.WithLabelValues("UpdateReview").Inc()
+	then := time.Now()
+
+	s.logger.Info("new update review request",
+		zap.Any("req", req))
+
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	review, err := s.core.UpdateReview(uint(req.Id), claims.UserID, req.Text, req.Language, int(req.Count))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("UpdateReview").Observe(time.Since(then).Seconds())
+
+	return review.ToPB(), nil
+}
+
+func (s *Server) ListReviewRevisions(ctx context.Context, req *pb.ListReviewRevisionsRequest) (*pb.ListReviewRevisionsResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ListReviewRevisions").Inc()
+	then := time.Now()
+
+	s.logger.Info("new list review revisions request",
+		zap.Any("req", req))
+
+	revisions, err := s.core.ListReviewRevisions(uint(req.ReviewId))
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	pbrevisions := make([]*pb.ReviewRevision, len(revisions))
+	for i, revision := range revisions {
+		pbrevisions[i] = revision.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListReviewRevisions").Observe(time.Since(then).Seconds())
+
+	return &pb.ListReviewRevisionsResponse{
+		Revisions: pbrevisions,
+	}, nil
+}
+
 func (s *Server) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*emptypb.Empty, error) {
 	metrics.RequestTotal.WithLabelValues("DeleteReview").Inc()
 	then := time.Now()
@@ -48,8 +167,14 @@ func (s *Server) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest)
 	s.logger.Info("new delete review request",
 		zap.Any("req", req))
 
-	if err := s.core.DeleteReview(uint(req.Id)); err != nil {
-		return &emptypb.Empty{}, err
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return &emptypb.Empty{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	err := s.core.DeleteReview(uint(req.Id), claims.UserID, claims.Role.Can(authz.PermDeleteAnyReview))
+	if err != nil {
+		return &emptypb.Empty{}, reviewStatus(err)
 	}
 
 	metrics.RequestDuration.WithLabelValues("DeleteReview").Observe(time.Since(then).Seconds())
@@ -74,6 +199,76 @@ func (s *Server) GetMark(ctx context.Context, req *pb.GetMarkRequest) (*pb.Mark,
 	return mark.ToPB(), nil
 }
 
+func (s *Server) GetReleaseGroupMark(ctx context.Context, req *pb.GetReleaseGroupMarkRequest) (*pb.Mark, error) {
+	metrics.RequestTotal.WithLabelValues("GetReleaseGroupMark").Inc()
+	then := time.Now()
+
+	s.logger.Info("new get release group mark request",
+		zap.Any("req", req))
+
+	mark, err := s.core.GetReleaseGroupMark(req.ReleaseGroupId)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("GetReleaseGroupMark").Observe(time.Since(then).Seconds())
+
+	return mark.ToPB(), nil
+}
+
+func (s *Server) GetArtistMark(ctx context.Context, req *pb.GetArtistMarkRequest) (*pb.Mark, error) {
+	metrics.RequestTotal.WithLabelValues("GetArtistMark").Inc()
+	then := time.Now()
+
+	s.logger.Info("new get artist mark request",
+		zap.Any("req", req))
+
+	mark, err := s.core.GetArtistMark(req.ArtistId)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("GetArtistMark").Observe(time.Since(then).Seconds())
+
+	return mark.ToPB(), nil
+}
+
+func (s *Server) ListMarks(ctx context.Context, req *pb.ListMarksRequest) (*pb.ListMarksResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ListMarks").Inc()
+	then := time.Now()
+
+	s.logger.Info("new list marks request",
+		zap.Any("req", req))
+
+	order, desc, err := core.ParseMarkOrder(req.OrderBy)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	filter := entity.MarkFilter{
+		MinReviews: int(req.MinReviews),
+		OrderBy:    order,
+		Desc:       desc,
+	}
+
+	marks, next, err := s.core.ListMarks(filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	pbmarks := make([]*pb.Mark, len(marks))
+	for i, mark := range marks {
+		pbmarks[i] = mark.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListMarks").Observe(time.Since(then).Seconds())
+
+	return &pb.ListMarksResponse{
+		Marks:         pbmarks,
+		NextPageToken: next,
+	}, nil
+}
+
 func (s *Server) GetReviews(ctx context.Context, req *pb.GetReviewsRequest) (*pb.GetReviewsResponse, error) {
 	metrics.RequestTotal.WithLabelValues("GetReviews").Inc()
 	then := time.Now()
@@ -81,51 +276,116 @@ func (s *Server) GetReviews(ctx context.Context, req *pb.GetReviewsRequest) (*pb
 	s.logger.Info("new get reviews request",
 		zap.Any("req", req))
 
-	reviews, err := s.core.GetReviewsByReleaseID(req.ReleaseId)
+	order, desc, err := core.ParseReviewOrder(req.OrderBy)
 	if err != nil {
-		return nil, err
+		return nil, reviewStatus(err)
+	}
+
+	filter := entity.ReviewFilter{
+		ReleaseID: req.ReleaseId,
+		Kind:      entity.ReviewKind(req.Kind),
+		MinScore:  entity.MinScore,
+		MaxScore:  entity.MaxScore,
+		Language:  req.Language,
+		OrderBy:   order,
+		Desc:      desc,
 	}
 
-	pbreviews := make([]*pb.Review, len(reviews))
-	for i, review := range reviews {
+	if req.MinScore != nil {
+		filter.MinScore = int(*req.MinScore)
+	}
+
+	if req.MaxScore != nil {
+		filter.MaxScore = int(*req.MaxScore)
+	}
+
+	page, err := s.core.ListReviews(filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	pbreviews := make([]*pb.Review, len(page.Reviews))
+	for i, review := range page.Reviews {
 		pbreviews[i] = review.ToPB()
 	}
 
 	metrics.RequestDuration.WithLabelValues("GetReviews").Observe(time.Since(then).Seconds())
 
 	return &pb.GetReviewsResponse{
-		Reviews: pbreviews,
+		Reviews:       pbreviews,
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
-func (s *Server) IncLike(ctx context.Context, req *pb.IncLikeRequest) (*emptypb.Empty, error) {
-	metrics.RequestTotal.WithLabelValues("IncLike").Inc()
+func (s *Server) LikeReview(ctx context.Context, req *pb.LikeReviewRequest) (*pb.LikeReviewResponse, error) {
+	metrics.RequestTotal.WithLabelValues("LikeReview").Inc()
 	then := time.Now()
 
-	s.logger.Info("new inc like request",
+	s.logger.Info("new like review request",
 		zap.Any("req", req))
 
-	if err := s.core.IncLike(uint(req.ReviewId)); err != nil {
-		return &emptypb.Empty{}, err
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	metrics.RequestDuration.WithLabelValues("IncLike").Observe(time.Since(then).Seconds())
+	likes, err := s.core.LikeReview(uint(req.ReviewId), claims.UserID)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
 
-	return &emptypb.Empty{}, nil
+	metrics.RequestDuration.WithLabelValues("LikeReview").Observe(time.Since(then).Seconds())
+
+	return &pb.LikeReviewResponse{
+		Likes: likes,
+	}, nil
 }
 
-func (s *Server) DecLike(ctx context.Context, req *pb.DecLikeRequest) (*emptypb.Empty, error) {
-	metrics.RequestTotal.WithLabelValues("DecLike").Inc()
+func (s *Server) UnlikeReview(ctx context.Context, req *pb.UnlikeReviewRequest) (*pb.UnlikeReviewResponse, error) {
+	metrics.RequestTotal.WithLabelValues("UnlikeReview").Inc()
 	then := time.Now()
 
-	s.logger.Info("new dec like request",
+	s.logger.Info("new unlike review request",
 		zap.Any("req", req))
 
-	if err := s.core.DecLike(uint(req.ReviewId)); err != nil {
-		return &emptypb.Empty{}, err
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	metrics.RequestDuration.WithLabelValues("DecLike").Observe(time.Since(then).Seconds())
+	likes, err := s.core.UnlikeReview(uint(req.ReviewId), claims.UserID)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
 
-	return &emptypb.Empty{}, nil
+	metrics.RequestDuration.WithLabelValues("UnlikeReview").Observe(time.Since(then).Seconds())
+
+	return &pb.UnlikeReviewResponse{
+		Likes: likes,
+	}, nil
+}
+
+func (s *Server) ListReviewLikers(ctx context.Context, req *pb.ListReviewLikersRequest) (*pb.ListReviewLikersResponse, error) {
+	metrics.RequestTotal.WithLabelValues("ListReviewLikers").Inc()
+	then := time.Now()
+
+	s.logger.Info("new list review likers request",
+		zap.Any("req", req))
+
+	likes, next, err := s.core.ListReviewLikers(uint(req.ReviewId), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, reviewStatus(err)
+	}
+
+	pblikes := make([]*pb.ReviewLike, len(likes))
+	for i, like := range likes {
+		pblikes[i] = like.ToPB()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListReviewLikers").Observe(time.Since(then).Seconds())
+
+	return &pb.ListReviewLikersResponse{
+		Likers:        pblikes,
+		NextPageToken: next,
+	}, nil
 }
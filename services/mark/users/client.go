@@ -0,0 +1,83 @@
+// Package users keeps the counters the user service holds for review
+// authors in step with the mark service.
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Client struct {
+	cc     pb.UserServiceClient
+	logger *logger.Logger
+}
+
+func NewClient(cc pb.UserServiceClient, logger *logger.Logger) *Client {
+	return &Client{
+		cc:     cc,
+		logger: logger,
+	}
+}
+
+// IncLike counts a like received by the user. Deleted users are skipped.
+func (c *Client) IncLike(ctx context.Context, userID string) error {
+	_, err := c.cc.IncLike(ctx, &pb.IncLikeRequest{UserId: userID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		c.logger.Error("failed inc like",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed inc like: %w", err)
+	}
+
+	return nil
+}
+
+// DecLike takes back a like received by the user. Deleted users are skipped.
+func (c *Client) DecLike(ctx context.Context, userID string) error {
+	_, err := c.cc.DecLike(ctx, &pb.DecLikeRequest{UserId: userID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		c.logger.Error("failed dec like",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed dec like: %w", err)
+	}
+
+	return nil
+}
+
+// IncReview counts a review written by the user. Deleted users are skipped.
+func (c *Client) IncReview(ctx context.Context, userID string) error {
+	_, err := c.cc.IncReview(ctx, &pb.IncReviewRequest{UserId: userID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		c.logger.Error("failed inc review",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed inc review: %w", err)
+	}
+
+	return nil
+}
+
+// DecReview takes back a review written by the user. Deleted users are
+// skipped.
+func (c *Client) DecReview(ctx context.Context, userID string) error {
+	_, err := c.cc.DecReview(ctx, &pb.DecReviewRequest{UserId: userID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		c.logger.Error("failed dec review",
+			zap.String("user_id", userID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed dec review: %w", err)
+	}
+
+	return nil
+}
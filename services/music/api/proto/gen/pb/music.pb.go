@@ -22,18 +22,22 @@ const (
 )
 
 type Release struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
-	Mbid           string                 `protobuf:"bytes,2,opt,name=mbid,proto3" json:"mbid,omitempty"`
-	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	ReleaseGroupId string                 `protobuf:"bytes,4,opt,name=release_group_id,json=releaseGroupId,proto3" json:"release_group_id,omitempty"`
-	Status         *string                `protobuf:"bytes,7,opt,name=status,proto3,oneof" json:"status,omitempty"` // Official, Bootleg
-	Country        *string                `protobuf:"bytes,8,opt,name=country,proto3,oneof" json:"country,omitempty"`
-	Date           *string                `protobuf:"bytes,9,opt,name=date,proto3,oneof" json:"date,omitempty"`      // "2025-03-14"
-	Format         *string                `protobuf:"bytes,10,opt,name=format,proto3,oneof" json:"format,omitempty"` // CD, Digital File
-	TrackCount     int32                  `protobuf:"varint,11,opt,name=track_count,json=trackCount,proto3" json:"track_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
+	Mbid             string                 `protobuf:"bytes,2,opt,name=mbid,proto3" json:"mbid,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseGroupId   string                 `protobuf:"bytes,4,opt,name=release_group_id,json=releaseGroupId,proto3" json:"release_group_id,omitempty"`
+	Status           *string                `protobuf:"bytes,7,opt,name=status,proto3,oneof" json:"status,omitempty"` // Official, Bootleg
+	Country          *string                `protobuf:"bytes,8,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Date             *string                `protobuf:"bytes,9,opt,name=date,proto3,oneof" json:"date,omitempty"`      // "2025-03-14"
+	Format           *string                `protobuf:"bytes,10,opt,name=format,proto3,oneof" json:"format,omitempty"` // CD, Digital File
+	TrackCount       int32                  `protobuf:"varint,11,opt,name=track_count,json=trackCount,proto3" json:"track_count,omitempty"`
+	ArtistId         string                 `protobuf:"bytes,12,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`                                 // of the release group
+	PrimaryType      string                 `protobuf:"bytes,13,opt,name=primary_type,json=primaryType,proto3" json:"primary_type,omitempty"`                        // of the release group: Album, Single, EP
+	Genres           []string               `protobuf:"bytes,14,rep,name=genres,proto3" json:"genres,omitempty"`                                                     // of the release group
+	FirstReleaseDate *string                `protobuf:"bytes,15,opt,name=first_release_date,json=firstReleaseDate,proto3,oneof" json:"first_release_date,omitempty"` // of the release group
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Release) Reset() {
@@ -129,6 +133,34 @@ func (x *Release) GetTrackCount() int32 {
 	return 0
 }
 
+func (x *Release) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *Release) GetPrimaryType() string {
+	if x != nil {
+		return x.PrimaryType
+	}
+	return ""
+}
+
+func (x *Release) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Release) GetFirstReleaseDate() string {
+	if x != nil && x.FirstReleaseDate != nil {
+		return *x.FirstReleaseDate
+	}
+	return ""
+}
+
 type SearchResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -773,7 +805,7 @@ var File_music_proto protoreflect.FileDescriptor
 
 const file_music_proto_rawDesc = "" +
 	"\n" +
-	"\vmusic.proto\"\xab\x02\n" +
+	"\vmusic.proto\"\xcd\x03\n" +
 	"\aRelease\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04mbid\x18\x02 \x01(\tR\x04mbid\x12\x14\n" +
@@ -785,12 +817,17 @@ const file_music_proto_rawDesc = "" +
 	"\x06format\x18\n" +
 	" \x01(\tH\x03R\x06format\x88\x01\x01\x12\x1f\n" +
 	"\vtrack_count\x18\v \x01(\x05R\n" +
-	"trackCountB\t\n" +
+	"trackCount\x12\x1b\n" +
+	"\tartist_id\x18\f \x01(\tR\bartistId\x12!\n" +
+	"\fprimary_type\x18\r \x01(\tR\vprimaryType\x12\x16\n" +
+	"\x06genres\x18\x0e \x03(\tR\x06genres\x121\n" +
+	"\x12first_release_date\x18\x0f \x01(\tH\x04R\x10firstReleaseDate\x88\x01\x01B\t\n" +
 	"\a_statusB\n" +
 	"\n" +
 	"\b_countryB\a\n" +
 	"\x05_dateB\t\n" +
-	"\a_format\"\xd4\x01\n" +
+	"\a_formatB\x15\n" +
+	"\x13_first_release_date\"\xd4\x01\n" +
 	"\fSearchResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04mbid\x18\x02 \x01(\tR\x04mbid\x12\x14\n" +
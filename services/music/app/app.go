@@ -8,11 +8,13 @@ import (
 	"os"
 	"sync"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/music/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/music/cache"
 	"github.com/osamikoyo/music-and-marks/services/music/config"
 	"github.com/osamikoyo/music-and-marks/services/music/core"
+	"github.com/osamikoyo/music-and-marks/services/music/entity"
 	"github.com/osamikoyo/music-and-marks/services/music/fetcher"
 	"github.com/osamikoyo/music-and-marks/services/music/loader"
 	"github.com/osamikoyo/music-and-marks/services/music/repository"
@@ -85,6 +87,13 @@ func SetupApp() (*App, error) {
 
 	logger.Info("connected to database")
 
+	if err = migrate(db); err != nil {
+		logger.Error("failed migrate db",
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed migrate db: %w", err)
+	}
+
 	repo := repository.NewRepository(db, logger)
 	cache := cache.NewCache(cfg, logger)
 
@@ -93,8 +102,10 @@ func SetupApp() (*App, error) {
 	fetcher, fclient := fetcher.NewFetcher(loader, repo, logger, cfg.SearchRequestTimeout)
 	core := core.NewMusicCore(repo, cache, fclient, cfg.RepositoryTimeout)
 
+	verifier, _ := authz.NewRemoteVerifier(cfg.JwksURL, cfg.HS256Secret())
+	interceptor := authz.UnaryServerInterceptor(verifier, server.Policy)
 	server := server.NewServer(core, logger)
-	grpcsrv := grpc.NewServer()
+	grpcsrv := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
 	pb.RegisterMusicServiceServer(grpcsrv, server)
 
 	logger.Info("app setuped successfully")
@@ -107,6 +118,28 @@ func SetupApp() (*App, error) {
 	}, nil
 }
 
+// migrate creates the catalog tables on an empty database and adds the
+// release group columns charts are filtered by to older schemas.
+func migrate(db *gorm.DB) error {
+	migrator := db.Migrator()
+
+	if !migrator.HasTable(&entity.ReleaseGroup{}) {
+		return db.AutoMigrate(&entity.Artist{}, &entity.ReleaseGroup{}, &entity.Release{})
+	}
+
+	for _, field := range []string{"PrimaryType", "Genres"} {
+		if migrator.HasColumn(&entity.ReleaseGroup{}, field) {
+			continue
+		}
+
+		if err := migrator.AddColumn(&entity.ReleaseGroup{}, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (a *App) Run(ctx context.Context) error {
 	a.logger.Info("starting app")
 
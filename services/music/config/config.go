@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -24,6 +25,8 @@ type Config struct {
 	SearchRequestTimeout time.Duration `yaml:"search_request_timeout" mapstructure:"search_request_timeout"`
 	RepositoryTimeout    time.Duration `yaml:"repo_timeout" mapstructure:"repo_timeout"`
 
+	authz.VerifierConfig `yaml:",inline" mapstructure:",squash"`
+
 	Cache    CacheConfig    `yaml:"cache" mapstructure:"cache"`
 	Postgres PostgresConfig `yaml:"postgres" mapstructure:"postgres"`
 }
@@ -111,6 +114,10 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.SetDefault("repo_timeout", 30*time.Second)
 	v.SetDefault("search_request_timeout", 30*time.Second)
 
+	v.SetDefault("jwt_key", authz.DefaultJwtKey)
+	v.SetDefault("jwks_url", authz.DefaultJwksURL)
+	v.SetDefault("accept_hs256", true)
+
 	v.SetDefault("cache.default_exp_time", 5*time.Minute)
 	v.SetDefault("cahce.exp_items_purge_timeout", 10*time.Minute)
 
@@ -139,6 +146,10 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.BindEnv("repo_timeout", "APP_REPO_TIMEOUT")
 	v.BindEnv("search_request_timeout", "APP_SEARCH_REQUEST_TIMEOUT")
 
+	v.BindEnv("jwt_key", "APP_JWT_KEY")
+	v.BindEnv("jwks_url", "APP_JWKS_URL")
+	v.BindEnv("accept_hs256", "APP_ACCEPT_HS256")
+
 	v.BindEnv("cache.default_exp_time", "APP_EXP_TIME")
 	v.BindEnv("cache.exp_items_purge_timeout", "APP_EXP_ITEMS_PURGE_TIMEOUT")
 
@@ -158,5 +169,9 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 		return nil, fmt.Errorf("failed unmarshal config: %w", err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &cfg, nil
 }
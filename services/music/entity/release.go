@@ -23,14 +23,18 @@ type Release struct {
 
 func (r *Release) ToPB() *pb.Release {
 	return &pb.Release{
-		Id:             r.ID,
-		Mbid:           r.MBID,
-		Title:          r.Title,
-		ReleaseGroupId: r.ReleaseGroupID,
-		Status:         &r.Status,
-		Country:        &r.Country,
-		Date:           r.Date,
-		Format:         &r.Format,
-		TrackCount:     int32(r.TrackCount),
+		Id:               r.ID,
+		Mbid:             r.MBID,
+		Title:            r.Title,
+		ReleaseGroupId:   r.ReleaseGroupID,
+		Status:           &r.Status,
+		Country:          &r.Country,
+		Date:             r.Date,
+		Format:           &r.Format,
+		TrackCount:       int32(r.TrackCount),
+		ArtistId:         r.ReleaseGroup.ArtistID,
+		PrimaryType:      r.ReleaseGroup.PrimaryType,
+		Genres:           r.ReleaseGroup.Genres,
+		FirstReleaseDate: r.ReleaseGroup.FirstReleaseDate,
 	}
 }
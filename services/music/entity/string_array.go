@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StringArray is a text[] column. Postgres is used through database/sql,
+// which scans arrays as their text form rather than into []string.
+type StringArray []string
+
+func (a *StringArray) Scan(src any) error {
+	var text []byte
+
+	switch src := src.(type) {
+	case nil:
+		*a = nil
+
+		return nil
+	case string:
+		text = []byte(src)
+	case []byte:
+		text = src
+	default:
+		return fmt.Errorf("cannot scan %T into a string array", src)
+	}
+
+	var values []string
+	if err := pgtype.NewMap().Scan(pgtype.TextArrayOID, pgtype.TextFormatCode, text, &values); err != nil {
+		return fmt.Errorf("failed scan string array: %w", err)
+	}
+
+	*a = values
+
+	return nil
+}
+
+func (a StringArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	text, err := pgtype.NewMap().Encode(pgtype.TextArrayOID, pgtype.TextFormatCode, []string(a), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed encode string array: %w", err)
+	}
+
+	return string(text), nil
+}
@@ -2,6 +2,7 @@ package fetcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,7 +10,6 @@ import (
 	"github.com/osamikoyo/music-and-marks/services/music/loader"
 	"github.com/osamikoyo/music-and-marks/services/music/repository"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -86,35 +86,42 @@ func (f *Fetcher) fetch(query string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
 	defer cancel()
 
-	g, ctx := errgroup.WithContext(ctx)
+	if err = f.repo.CreateArtist(ctx, artist.ToEntity()); err != nil && !errors.Is(err, repository.ErrAlreadyExist) {
+		f.logger.Error("failed create fetched artist",
+			zap.Any("artist", artist),
+			zap.Error(err))
+
+		return fmt.Errorf("failed create fetched artist: %w", err)
+	}
 
-	g.Go(func() error {
-		if err = f.repo.CreateArtist(ctx, artist.ToEntity()); err != nil {
-			f.logger.Error("failed create fetched artist",
-				zap.Any("artist", artist),
+	// the release group tells which artist the release belongs to; both are
+	// saved before the release that refers to them
+	if credited := album.ArtistEntity(); credited != nil && album.ReleaseGroup.ID != "" {
+		if err = f.repo.CreateArtist(ctx, credited); err != nil && !errors.Is(err, repository.ErrAlreadyExist) {
+			f.logger.Error("failed create credited artist",
+				zap.Any("artist", credited),
 				zap.Error(err))
 
-			return fmt.Errorf("failed create fetched artist: %w", err)
+			return fmt.Errorf("failed create credited artist: %w", err)
 		}
 
-		return nil
-	})
+		group := album.ReleaseGroupEntity()
 
-	g.Go(func() error {
-		if err = f.repo.CreateRelease(ctx, album.ToEntity()); err != nil {
-			f.logger.Error("failed create fetched artist",
-				zap.Any("artist", artist),
+		if err = f.repo.CreateReleaseGroup(ctx, group); err != nil && !errors.Is(err, repository.ErrAlreadyExist) {
+			f.logger.Error("failed create fetched release group",
+				zap.Any("release_group", group),
 				zap.Error(err))
 
-			return fmt.Errorf("failed create fetched artist: %w", err)
+			return fmt.Errorf("failed create fetched release group: %w", err)
 		}
+	}
 
-		return nil
-	})
-
-	if err = g.Wait(); err != nil {
-		f.logger.Error("failed fetch and create artist and album",
+	if err = f.repo.CreateRelease(ctx, album.ToEntity()); err != nil && !errors.Is(err, repository.ErrAlreadyExist) {
+		f.logger.Error("failed create fetched release",
+			zap.Any("release", album),
 			zap.Error(err))
+
+		return fmt.Errorf("failed create fetched release: %w", err)
 	}
 
 	return nil
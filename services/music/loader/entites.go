@@ -23,19 +23,77 @@ type Release struct {
 		TrackCount int    `json:"track-count"`
 	} `json:"media"`
 	ReleaseGroup struct {
-		ID             string   `json:"id"`
-		Title          string   `json:"title"`
-		PrimaryType    string   `json:"primary-type"` // Album, Single, EP
-		SecondaryTypes []string `json:"secondary-types,omitempty"`
+		ID               string   `json:"id"`
+		Title            string   `json:"title"`
+		PrimaryType      string   `json:"primary-type"` // Album, Single, EP
+		SecondaryTypes   []string `json:"secondary-types,omitempty"`
+		FirstReleaseDate string   `json:"first-release-date,omitempty"`
 	} `json:"release-group"`
 	ArtistCredit []struct {
 		Name       string `json:"name"`
 		JoinPhrase string `json:"joinphrase,omitempty"`
 		Artist     struct {
-			ID   string `json:"id"`
-			Name string `json:"name"`
+			ID       string `json:"id"`
+			Name     string `json:"name"`
+			SortName string `json:"sort-name"`
 		} `json:"artist"`
 	} `json:"artist-credit"`
+	Tags []struct {
+		Count int    `json:"count"`
+		Name  string `json:"name"`
+	} `json:"tags,omitempty"`
+}
+
+// ArtistEntity returns the first credited artist of the release, nil when
+// nobody is credited.
+func (r *Release) ArtistEntity() *entity.Artist {
+	if len(r.ArtistCredit) == 0 {
+		return nil
+	}
+
+	artist := r.ArtistCredit[0].Artist
+
+	return &entity.Artist{
+		ID:       artist.ID,
+		Name:     artist.Name,
+		SortName: artist.SortName,
+	}
+}
+
+// ReleaseGroupEntity returns the release group of the release, which
+// belongs to its first credited artist and is tagged with the genres of
+// the release. Partial dates like "1997" are dropped since the column holds
+// full dates.
+func (r *Release) ReleaseGroupEntity() *entity.ReleaseGroup {
+	group := r.ReleaseGroup
+
+	var date *string
+	if len(group.FirstReleaseDate) == len("2006-01-02") {
+		date = &group.FirstReleaseDate
+	}
+
+	var artistID string
+	if artist := r.ArtistEntity(); artist != nil {
+		artistID = artist.ID
+	}
+
+	genres := make([]string, 0, len(r.Tags))
+	for _, tag := range r.Tags {
+		if tag.Name != "" {
+			genres = append(genres, tag.Name)
+		}
+	}
+
+	return &entity.ReleaseGroup{
+		ID:               group.ID,
+		MBID:             group.ID,
+		Title:            group.Title,
+		ArtistID:         artistID,
+		PrimaryType:      group.PrimaryType,
+		SecondaryTypes:   group.SecondaryTypes,
+		FirstReleaseDate: date,
+		Genres:           genres,
+	}
 }
 
 func (r *Release) ToEntity() *entity.Release {
@@ -51,7 +51,7 @@ func (r *Repository) CreateArtist(ctx context.Context, artist *entity.Artist) er
 
 	r.logger.Info("artist created successfully")
 
-	return ErrNilInput
+	return nil
 }
 
 func (r *Repository) Search(ctx context.Context, query string, pageSize, pageIndex int) ([]entity.SearchResult, error) {
@@ -159,7 +159,31 @@ func (r *Repository) CreateRelease(ctx context.Context, release *entity.Release)
 
 	r.logger.Info("release created successfully")
 
-	return ErrNilInput
+	return nil
+}
+
+func (r *Repository) CreateReleaseGroup(ctx context.Context, releaseGroup *entity.ReleaseGroup) error {
+	if releaseGroup == nil {
+		return ErrNilInput
+	}
+
+	r.logger.Info("creating release group",
+		zap.Any("release_group", releaseGroup))
+
+	if err := r.db.WithContext(ctx).Create(releaseGroup).Error; err != nil {
+		r.logger.Error("failed create release group",
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrAlreadyExist
+		}
+
+		return ErrInternal
+	}
+
+	r.logger.Info("release group created successfully")
+
+	return nil
 }
 
 func (r *Repository) GetArtistByID(ctx context.Context, id uuid.UUID) (*entity.Artist, error) {
@@ -199,7 +223,8 @@ func (r *Repository) GetReleaseByID(ctx context.Context, id uuid.UUID) (*entity.
 
 	var release entity.Release
 
-	if err := r.db.WithContext(ctx).First(&release, id).Error; err != nil {
+	// the release group tells which artist the release belongs to
+	if err := r.db.WithContext(ctx).Preload("ReleaseGroup").First(&release, id).Error; err != nil {
 		r.logger.Error("failed fetch release",
 			zap.String("id", id.String()),
 			zap.Error(err))
@@ -0,0 +1,8 @@
+package server
+
+import "github.com/osamikoyo/music-and-marks/authz"
+
+// Policy guards no MusicService method: the catalog is public. The
+// interceptor still verifies forwarded tokens and puts the caller on the
+// context.
+var Policy = authz.Policy{}
@@ -9,7 +9,10 @@ import (
 	"github.com/osamikoyo/music-and-marks/services/music/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/music/core"
 	"github.com/osamikoyo/music-and-marks/services/music/metrics"
+	"github.com/osamikoyo/music-and-marks/services/music/repository"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var ErrEmptyRequest = errors.New("request is empty")
@@ -27,6 +30,19 @@ func NewServer(core *core.MusicCore, logger *logger.Logger) *Server {
 	}
 }
 
+// lookupStatus tells callers looking up catalog entries by id whether the
+// entry is missing or the id is malformed.
+func lookupStatus(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrEmptyField), errors.Is(err, core.ErrUIDFailed):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
 func (s *Server) GetArtist(ctx context.Context, req *pb.GetArtistRequest) (*pb.GetArtistResponse, error) {
 	if req == nil {
 		return nil, ErrEmptyRequest
@@ -37,7 +53,7 @@ func (s *Server) GetArtist(ctx context.Context, req *pb.GetArtistRequest) (*pb.G
 
 	artist, err := s.core.GetArtist(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, lookupStatus(err)
 	}
 
 	metrics.RequestDuration.WithLabelValues("GetArtist").Observe(time.Since(then).Seconds())
@@ -57,7 +73,7 @@ func (s *Server) GetRelease(ctx context.Context, req *pb.GetReleaseRequest) (*pb
 
 	release, err := s.core.GetRelease(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, lookupStatus(err)
 	}
 
 	metrics.RequestDuration.WithLabelValues("GetRelease").Observe(time.Since(then).Seconds())
@@ -2,16 +2,21 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"sync"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/user/config"
 	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/mailer"
 	"github.com/osamikoyo/music-and-marks/services/user/metrics"
+	"github.com/osamikoyo/music-and-marks/services/user/oidc"
 	"github.com/osamikoyo/music-and-marks/services/user/repository"
 	"github.com/osamikoyo/music-and-marks/services/user/server"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -23,6 +28,7 @@ import (
 
 type App struct {
 	cfg    *config.Config
+	keys   *authz.KeyRing
 	logger *logger.Logger
 	grpc   *grpc.Server
 }
@@ -60,11 +66,26 @@ func SetupApp(config_path string) (*App, error) {
 		return nil, err
 	}
 
+	keys, err := setupKeys(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	repo := repository.NewRepository(db, logger)
-	core := core.NewUserCore(repo, cfg)
+	core := core.NewUserCore(repo, setupMailer(logger, cfg), keys, setupProviders(logger, cfg), cfg)
+
+	if err = core.BootstrapAdmin(); err != nil {
+		logger.Error("failed to bootstrap admin",
+			zap.String("email", cfg.BootstrapAdmin.Email),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed bootstrap admin: %v", err)
+	}
+
+	interceptor := authz.UnaryServerInterceptor(keys.Verifier(), server.Policy)
 	server := server.NewUserServiceServer(core, logger)
 
-	grpcSrv := grpc.NewServer()
+	grpcSrv := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
 
 	pb.RegisterUserServiceServer(grpcSrv, server)
 
@@ -73,6 +94,7 @@ func SetupApp(config_path string) (*App, error) {
 	return &App{
 		grpc:   grpcSrv,
 		cfg:    cfg,
+		keys:   keys,
 		logger: logger,
 	}, nil
 }
@@ -90,11 +112,67 @@ func setupDB(logger *logger.Logger, cfg *config.Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed setup database: %v", err)
 	}
 
+	if err = db.AutoMigrate(&entity.User{}, &entity.Session{}, &entity.RefreshToken{}, &entity.ActionToken{},
+		&entity.LoginThrottle{}, &entity.AuditEntry{}, &entity.RecoveryCode{}, &entity.PersonalToken{},
+		&entity.Identity{}, &entity.OIDCState{}, &entity.Follow{}); err != nil {
+		logger.Error("failed to migrate db",
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed migrate database: %v", err)
+	}
+
 	logger.Info("successfully setup db")
 
 	return db, nil
 }
 
+func setupKeys(logger *logger.Logger, cfg *config.Config) (*authz.KeyRing, error) {
+	logger.Info("setuping signing keys...",
+		zap.String("keys_dir", cfg.Signing.KeysDir),
+		zap.String("active_kid", cfg.Signing.ActiveKid))
+
+	var secret []byte
+	if cfg.Signing.HS256() {
+		secret = []byte(cfg.JwtKey)
+	}
+
+	keys, err := authz.LoadKeyRing(cfg.Signing.KeysDir, cfg.Signing.ActiveKid, secret)
+	if err != nil {
+		logger.Error("failed to load signing keys",
+			zap.String("keys_dir", cfg.Signing.KeysDir),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed load signing keys: %v", err)
+	}
+
+	return keys, nil
+}
+
+func setupMailer(logger *logger.Logger, cfg *config.Config) core.Mailer {
+	logger.Info("setuping mailer...",
+		zap.String("driver", cfg.Mailer.Driver))
+
+	if cfg.Mailer.Driver == config.MailerDriverSMTP {
+		return mailer.NewSMTPMailer(&cfg.Mailer, logger)
+	}
+
+	return mailer.NewFileMailer(&cfg.Mailer, logger)
+}
+
+func setupProviders(logger *logger.Logger, cfg *config.Config) map[string]core.IdentityProvider {
+	providers := make(map[string]core.IdentityProvider, len(cfg.OIDC.Providers))
+
+	for name, provider := range cfg.OIDC.Providers {
+		logger.Info("setuping oidc provider...",
+			zap.String("name", name),
+			zap.String("issuer", provider.Issuer))
+
+		providers[name] = oidc.NewProvider(provider)
+	}
+
+	return providers
+}
+
 func (a *App) Start(ctx context.Context) error {
 	a.logger.Info("starting app...")
 
@@ -118,6 +196,7 @@ func (a *App) Start(ctx context.Context) error {
 	var wg sync.WaitGroup
 
 	http.Handle("/metrics", promhttp.Handler())
+	http.HandleFunc(authz.JWKSPath, a.serveJWKS)
 
 	wg.Go(func() {
 		if err = http.ListenAndServe(a.cfg.MetricsAddr, nil); err != nil {
@@ -146,3 +225,25 @@ func (a *App) Start(ctx context.Context) error {
 
 	return nil
 }
+
+// serveJWKS publishes the public signing keys for services that verify
+// tokens locally.
+func (a *App) serveJWKS(w http.ResponseWriter, r *http.Request) {
+	set, err := a.keys.JWKS()
+	if err != nil {
+		a.logger.Error("failed build jwks",
+			zap.Error(err))
+
+		http.Error(w, "failed build jwks", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	if err = json.NewEncoder(w).Encode(set); err != nil {
+		a.logger.Error("failed write jwks",
+			zap.Error(err))
+	}
+}
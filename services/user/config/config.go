@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -12,10 +13,36 @@ import (
 const (
 	DefaultAddr         = "localhost:50051"
 	DefaultMetricsAddr  = "localhost:7979"
-	DefaultJwtKey       = "super-secret-jwt-key-change-in-production"
+	DefaultJwtKey       = authz.DefaultJwtKey
 	DefaultRTokenTTL    = 72 * time.Hour
 	DefaultATokenTTL    = 15 * time.Minute
 	DefaultDatabasePath = "storage/users.db"
+	DefaultRepoTimeout  = 30 * time.Second
+	DefaultVerifyTTL    = 24 * time.Hour
+	DefaultResetTTL     = time.Hour
+	DefaultMailerDriver = MailerDriverFile
+	DefaultMailFrom     = "music-and-marks <no-reply@localhost>"
+	DefaultLinkBaseURL  = "http://localhost:8080"
+	DefaultSMTPPort     = 587
+
+	DefaultMaxAccountFailures = 5
+	DefaultMaxIPFailures      = 50
+	DefaultFailureWindow      = 15 * time.Minute
+	DefaultLockoutDuration    = 15 * time.Minute
+	DefaultBackoffBase        = time.Second
+	DefaultBackoffMax         = time.Minute
+
+	DefaultMFAIssuer    = "music-and-marks"
+	DefaultMFATicketTTL = 5 * time.Minute
+
+	DefaultPersonalTokenTTL    = 90 * 24 * time.Hour
+	DefaultMaxPersonalTokenTTL = 365 * 24 * time.Hour
+	DefaultMaxPersonalTokens   = 20
+
+	DefaultOIDCStateTTL = 10 * time.Minute
+
+	MailerDriverFile = "file"
+	MailerDriverSMTP = "smtp"
 )
 
 type Config struct {
@@ -25,6 +52,110 @@ type Config struct {
 	RTokenTTL    time.Duration `yaml:"refresh_token_ttl" mapstructure:"refresh_token_ttl"`
 	ATokenTTL    time.Duration `yaml:"access_token_ttl" mapstructure:"access_token_ttl"`
 	DatabasePath string        `yaml:"database_path" mapstructure:"database_path"`
+	RepoTimeout  time.Duration `yaml:"repo_timeout" mapstructure:"repo_timeout"`
+	VerifyTTL    time.Duration `yaml:"verify_token_ttl" mapstructure:"verify_token_ttl"`
+	ResetTTL     time.Duration `yaml:"reset_token_ttl" mapstructure:"reset_token_ttl"`
+
+	BootstrapAdmin BootstrapAdminConfig `yaml:"bootstrap_admin" mapstructure:"bootstrap_admin"`
+	Mailer         MailerConfig         `yaml:"mailer" mapstructure:"mailer"`
+	LoginGuard     LoginGuardConfig     `yaml:"login_guard" mapstructure:"login_guard"`
+	MFA            MFAConfig            `yaml:"mfa" mapstructure:"mfa"`
+	Signing        SigningConfig        `yaml:"signing" mapstructure:"signing"`
+	PersonalTokens PersonalTokensConfig `yaml:"personal_tokens" mapstructure:"personal_tokens"`
+	OIDC           OIDCConfig           `yaml:"oidc" mapstructure:"oidc"`
+}
+
+// OIDCConfig lists the identity providers users may sign in with, keyed by
+// the name used in URLs. StateTTL bounds the round trip to a provider.
+// Providers are configured in the file only, there are no env bindings.
+type OIDCConfig struct {
+	StateTTL  time.Duration                 `yaml:"state_ttl" mapstructure:"state_ttl"`
+	Providers map[string]OIDCProviderConfig `yaml:"providers" mapstructure:"providers"`
+}
+
+// OIDCProviderConfig holds the endpoints of a provider rather than
+// discovering them, so that a local mock server can stand in for it.
+// RedirectURL is the gateway callback registered with the provider.
+type OIDCProviderConfig struct {
+	Issuer       string   `yaml:"issuer" mapstructure:"issuer"`
+	ClientID     string   `yaml:"client_id" mapstructure:"client_id"`
+	ClientSecret string   `yaml:"client_secret" mapstructure:"client_secret"`
+	AuthURL      string   `yaml:"auth_url" mapstructure:"auth_url"`
+	TokenURL     string   `yaml:"token_url" mapstructure:"token_url"`
+	JwksURL      string   `yaml:"jwks_url" mapstructure:"jwks_url"`
+	RedirectURL  string   `yaml:"redirect_url" mapstructure:"redirect_url"`
+	Scopes       []string `yaml:"scopes" mapstructure:"scopes"`
+}
+
+// PersonalTokensConfig bounds personal access tokens. DefaultTTL applies
+// when no expiry is requested, MaxTTL caps requested ones and MaxPerUser
+// counts active tokens only.
+type PersonalTokensConfig struct {
+	DefaultTTL time.Duration `yaml:"default_ttl" mapstructure:"default_ttl"`
+	MaxTTL     time.Duration `yaml:"max_ttl" mapstructure:"max_ttl"`
+	MaxPerUser int           `yaml:"max_per_user" mapstructure:"max_per_user"`
+}
+
+// SigningConfig switches token signing from the shared jwt_key to the
+// private keys in KeysDir, one <kid>.pem per key. ActiveKid signs new
+// tokens; the other keys stay published on the JWKS endpoint. AcceptHS256
+// keeps tokens signed with jwt_key valid while clients migrate.
+type SigningConfig struct {
+	KeysDir     string `yaml:"keys_dir" mapstructure:"keys_dir"`
+	ActiveKid   string `yaml:"active_kid" mapstructure:"active_kid"`
+	AcceptHS256 bool   `yaml:"accept_hs256" mapstructure:"accept_hs256"`
+}
+
+// HS256 reports whether the shared jwt_key is still used to sign or verify.
+func (s SigningConfig) HS256() bool {
+	return s.KeysDir == "" || s.AcceptHS256
+}
+
+// MFAConfig configures TOTP two-factor authentication. Issuer is the name
+// authenticator apps show, TicketTTL bounds the second login step.
+type MFAConfig struct {
+	Issuer    string        `yaml:"issuer" mapstructure:"issuer"`
+	TicketTTL time.Duration `yaml:"ticket_ttl" mapstructure:"ticket_ttl"`
+}
+
+// LoginGuardConfig throttles failed logins. Failures older than
+// FailureWindow are forgotten. Each failure on an account doubles the
+// delay before the next attempt, starting at BackoffBase and capped at
+// BackoffMax; reaching a Max*Failures threshold locks the account or the
+// address for LockoutDuration.
+type LoginGuardConfig struct {
+	MaxAccountFailures int           `yaml:"max_account_failures" mapstructure:"max_account_failures"`
+	MaxIPFailures      int           `yaml:"max_ip_failures" mapstructure:"max_ip_failures"`
+	FailureWindow      time.Duration `yaml:"failure_window" mapstructure:"failure_window"`
+	LockoutDuration    time.Duration `yaml:"lockout_duration" mapstructure:"lockout_duration"`
+	BackoffBase        time.Duration `yaml:"backoff_base" mapstructure:"backoff_base"`
+	BackoffMax         time.Duration `yaml:"backoff_max" mapstructure:"backoff_max"`
+}
+
+// MailerConfig selects how verification and password reset mails are
+// delivered. The file driver only logs mails and optionally stores them in
+// Dir, the smtp driver sends them through SMTP.
+type MailerConfig struct {
+	Driver      string     `yaml:"driver" mapstructure:"driver"`
+	From        string     `yaml:"from" mapstructure:"from"`
+	Dir         string     `yaml:"dir" mapstructure:"dir"`
+	LinkBaseURL string     `yaml:"link_base_url" mapstructure:"link_base_url"`
+	SMTP        SMTPConfig `yaml:"smtp" mapstructure:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string `yaml:"host" mapstructure:"host"`
+	Port     int    `yaml:"port" mapstructure:"port"`
+	Username string `yaml:"username" mapstructure:"username"`
+	Password string `yaml:"password" mapstructure:"password"`
+}
+
+// BootstrapAdminConfig describes the account promoted to admin on startup
+// while no admin exists yet.
+type BootstrapAdminConfig struct {
+	Username string `yaml:"username" mapstructure:"username"`
+	Email    string `yaml:"email" mapstructure:"email"`
+	Password string `yaml:"password" mapstructure:"password"`
 }
 
 func NewConfig(path string, logger *logger.Logger) (*Config, error) {
@@ -38,6 +169,26 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	v.SetDefault("refresh_token_ttl", DefaultRTokenTTL)
 	v.SetDefault("access_token_ttl", DefaultATokenTTL)
 	v.SetDefault("database_path", DefaultDatabasePath)
+	v.SetDefault("repo_timeout", DefaultRepoTimeout)
+	v.SetDefault("verify_token_ttl", DefaultVerifyTTL)
+	v.SetDefault("reset_token_ttl", DefaultResetTTL)
+	v.SetDefault("mailer.driver", DefaultMailerDriver)
+	v.SetDefault("mailer.from", DefaultMailFrom)
+	v.SetDefault("mailer.link_base_url", DefaultLinkBaseURL)
+	v.SetDefault("mailer.smtp.port", DefaultSMTPPort)
+	v.SetDefault("login_guard.max_account_failures", DefaultMaxAccountFailures)
+	v.SetDefault("login_guard.max_ip_failures", DefaultMaxIPFailures)
+	v.SetDefault("login_guard.failure_window", DefaultFailureWindow)
+	v.SetDefault("login_guard.lockout_duration", DefaultLockoutDuration)
+	v.SetDefault("login_guard.backoff_base", DefaultBackoffBase)
+	v.SetDefault("login_guard.backoff_max", DefaultBackoffMax)
+	v.SetDefault("mfa.issuer", DefaultMFAIssuer)
+	v.SetDefault("mfa.ticket_ttl", DefaultMFATicketTTL)
+	v.SetDefault("signing.accept_hs256", true)
+	v.SetDefault("personal_tokens.default_ttl", DefaultPersonalTokenTTL)
+	v.SetDefault("personal_tokens.max_ttl", DefaultMaxPersonalTokenTTL)
+	v.SetDefault("personal_tokens.max_per_user", DefaultMaxPersonalTokens)
+	v.SetDefault("oidc.state_ttl", DefaultOIDCStateTTL)
 
 	v.SetEnvPrefix("APP")
 	v.AutomaticEnv()
@@ -57,6 +208,35 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 	_ = v.BindEnv("refresh_token_ttl", "APP_REFRESH_TOKEN_TTL")
 	_ = v.BindEnv("access_token_ttl", "APP_ACCESS_TOKEN_TTL")
 	_ = v.BindEnv("database_path", "APP_DATABASE_PATH")
+	_ = v.BindEnv("repo_timeout", "APP_REPO_TIMEOUT")
+	_ = v.BindEnv("bootstrap_admin.username", "APP_BOOTSTRAP_ADMIN_USERNAME")
+	_ = v.BindEnv("bootstrap_admin.email", "APP_BOOTSTRAP_ADMIN_EMAIL")
+	_ = v.BindEnv("bootstrap_admin.password", "APP_BOOTSTRAP_ADMIN_PASSWORD")
+	_ = v.BindEnv("verify_token_ttl", "APP_VERIFY_TOKEN_TTL")
+	_ = v.BindEnv("reset_token_ttl", "APP_RESET_TOKEN_TTL")
+	_ = v.BindEnv("mailer.driver", "APP_MAILER_DRIVER")
+	_ = v.BindEnv("mailer.from", "APP_MAILER_FROM")
+	_ = v.BindEnv("mailer.dir", "APP_MAILER_DIR")
+	_ = v.BindEnv("mailer.link_base_url", "APP_MAILER_LINK_BASE_URL")
+	_ = v.BindEnv("mailer.smtp.host", "APP_MAILER_SMTP_HOST")
+	_ = v.BindEnv("mailer.smtp.port", "APP_MAILER_SMTP_PORT")
+	_ = v.BindEnv("mailer.smtp.username", "APP_MAILER_SMTP_USERNAME")
+	_ = v.BindEnv("mailer.smtp.password", "APP_MAILER_SMTP_PASSWORD")
+	_ = v.BindEnv("login_guard.max_account_failures", "APP_LOGIN_GUARD_MAX_ACCOUNT_FAILURES")
+	_ = v.BindEnv("login_guard.max_ip_failures", "APP_LOGIN_GUARD_MAX_IP_FAILURES")
+	_ = v.BindEnv("login_guard.failure_window", "APP_LOGIN_GUARD_FAILURE_WINDOW")
+	_ = v.BindEnv("login_guard.lockout_duration", "APP_LOGIN_GUARD_LOCKOUT_DURATION")
+	_ = v.BindEnv("login_guard.backoff_base", "APP_LOGIN_GUARD_BACKOFF_BASE")
+	_ = v.BindEnv("login_guard.backoff_max", "APP_LOGIN_GUARD_BACKOFF_MAX")
+	_ = v.BindEnv("mfa.issuer", "APP_MFA_ISSUER")
+	_ = v.BindEnv("mfa.ticket_ttl", "APP_MFA_TICKET_TTL")
+	_ = v.BindEnv("signing.keys_dir", "APP_SIGNING_KEYS_DIR")
+	_ = v.BindEnv("signing.active_kid", "APP_SIGNING_ACTIVE_KID")
+	_ = v.BindEnv("signing.accept_hs256", "APP_SIGNING_ACCEPT_HS256")
+	_ = v.BindEnv("personal_tokens.default_ttl", "APP_PERSONAL_TOKENS_DEFAULT_TTL")
+	_ = v.BindEnv("personal_tokens.max_ttl", "APP_PERSONAL_TOKENS_MAX_TTL")
+	_ = v.BindEnv("personal_tokens.max_per_user", "APP_PERSONAL_TOKENS_MAX_PER_USER")
+	_ = v.BindEnv("oidc.state_ttl", "APP_OIDC_STATE_TTL")
 
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
@@ -69,7 +249,16 @@ func NewConfig(path string, logger *logger.Logger) (*Config, error) {
 		zap.Duration("access_token_ttl", cfg.ATokenTTL),
 		zap.Duration("refresh_token_ttl", cfg.RTokenTTL),
 		zap.Bool("jwt_key_set", cfg.JwtKey != DefaultJwtKey),
+		zap.String("signing_active_kid", cfg.Signing.ActiveKid),
+		zap.Bool("accept_hs256", cfg.Signing.HS256()),
 		zap.String("database_path", cfg.DatabasePath),
+		zap.Duration("repo_timeout", cfg.RepoTimeout),
+		zap.Bool("bootstrap_admin_set", cfg.BootstrapAdmin.Email != ""),
+		zap.String("mailer_driver", cfg.Mailer.Driver),
+		zap.Int("max_account_failures", cfg.LoginGuard.MaxAccountFailures),
+		zap.Int("max_ip_failures", cfg.LoginGuard.MaxIPFailures),
+		zap.Duration("lockout_duration", cfg.LoginGuard.LockoutDuration),
+		zap.Int("oidc_providers", len(cfg.OIDC.Providers)),
 	)
 
 	return &cfg, nil
@@ -80,10 +269,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("addr is required")
 	}
 
-	if c.JwtKey == "" || c.JwtKey == DefaultJwtKey {
+	if c.Signing.HS256() && (c.JwtKey == "" || c.JwtKey == DefaultJwtKey) {
 		return fmt.Errorf("jwt_key must be set and not default")
 	}
 
+	if c.Signing.KeysDir != "" && c.Signing.ActiveKid == "" {
+		return fmt.Errorf("signing.active_kid is required with signing.keys_dir")
+	}
+
 	if c.ATokenTTL <= 0 {
 		return fmt.Errorf("access_token_ttl must be positive")
 	}
@@ -100,6 +293,65 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("access_token_ttl should not exceed 1 hour")
 	}
 
+	if c.RepoTimeout <= 0 {
+		return fmt.Errorf("repo_timeout must be positive")
+	}
+
+	if c.VerifyTTL <= 0 || c.ResetTTL <= 0 {
+		return fmt.Errorf("verify_token_ttl and reset_token_ttl must be positive")
+	}
+
+	switch c.Mailer.Driver {
+	case MailerDriverFile:
+	case MailerDriverSMTP:
+		if c.Mailer.SMTP.Host == "" {
+			return fmt.Errorf("mailer.smtp.host is required for the smtp driver")
+		}
+	default:
+		return fmt.Errorf("unknown mailer driver %q", c.Mailer.Driver)
+	}
+
+	if c.Mailer.From == "" {
+		return fmt.Errorf("mailer.from should not be empty")
+	}
+
+	guard := c.LoginGuard
+	if guard.MaxAccountFailures <= 0 || guard.MaxIPFailures <= 0 {
+		return fmt.Errorf("login_guard failure thresholds must be positive")
+	}
+
+	if guard.FailureWindow <= 0 || guard.LockoutDuration <= 0 {
+		return fmt.Errorf("login_guard failure_window and lockout_duration must be positive")
+	}
+
+	if guard.BackoffBase < 0 || guard.BackoffMax < guard.BackoffBase {
+		return fmt.Errorf("login_guard backoff_max should not be less than backoff_base")
+	}
+
+	if c.MFA.Issuer == "" || c.MFA.TicketTTL <= 0 {
+		return fmt.Errorf("mfa.issuer must be set and mfa.ticket_ttl positive")
+	}
+
+	tokens := c.PersonalTokens
+	if tokens.DefaultTTL <= 0 || tokens.MaxTTL < tokens.DefaultTTL {
+		return fmt.Errorf("personal_tokens.max_ttl should not be less than a positive default_ttl")
+	}
+
+	if tokens.MaxPerUser <= 0 {
+		return fmt.Errorf("personal_tokens.max_per_user must be positive")
+	}
+
+	if c.OIDC.StateTTL <= 0 {
+		return fmt.Errorf("oidc.state_ttl must be positive")
+	}
+
+	for name, provider := range c.OIDC.Providers {
+		if provider.Issuer == "" || provider.ClientID == "" || provider.AuthURL == "" ||
+			provider.TokenURL == "" || provider.JwksURL == "" || provider.RedirectURL == "" {
+			return fmt.Errorf("oidc provider %q needs issuer, client_id, auth_url, token_url, jwks_url and redirect_url", name)
+		}
+	}
+
 	if c.DatabasePath == "" {
 		return fmt.Errorf("database_path should not be empty")
 	}
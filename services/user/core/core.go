@@ -3,12 +3,16 @@ package core
 import (
 	"context"
 	"errors"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/services/user/config"
 	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -21,21 +25,88 @@ var (
 	ErrInvalidToken     = errors.New("invalid jwt token")
 	ErrGetNewToken      = errors.New("fialed to get new token")
 	ErrInternal         = errors.New("internal error")
+	ErrSessionRevoked   = errors.New("session revoked")
+	ErrTokenReused      = errors.New("refresh token reused, session revoked")
+	ErrUnknownField     = errors.New("unknown field in update mask")
+	ErrUsernameTaken    = errors.New("username is already taken")
+	ErrEmailTaken       = errors.New("email is already taken")
+	ErrWrongPassword    = errors.New("current password is wrong")
+	ErrBootstrapAccount = errors.New("bootstrap admin email is registered to an unverified account or with another password")
+	ErrInvalidProfile   = errors.New("invalid profile field")
+	ErrInvalidOrder     = errors.New("invalid order_by")
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+
+	maxDisplayNameLen = 100
+	maxBioLen         = 500
 )
 
 type Repository interface {
 	CreateUser(ctx context.Context, user *entity.User) error
 	UpdateUser(ctx context.Context, update *entity.User) error
+	AddLikes(ctx context.Context, id uuid.UUID, delta int) error
+	AddReviews(ctx context.Context, id uuid.UUID, delta int) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
 	GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error)
-	CheckUser(ctx context.Context, password, email string) (string, error)
+	CheckUser(ctx context.Context, email, password string) (*entity.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*entity.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
+	CountUsersByRole(ctx context.Context, role string) (int64, error)
+	ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.UserCursor, limit int) ([]entity.User, error)
+	CountUsers(ctx context.Context, filter entity.UserFilter) (int64, error)
+
+	CreateSession(ctx context.Context, session *entity.Session, token *entity.RefreshToken) error
+	GetSession(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	GetRefreshToken(ctx context.Context, id uuid.UUID) (*entity.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, used uuid.UUID, next *entity.RefreshToken) (bool, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]entity.Session, error)
+
+	CreateActionToken(ctx context.Context, token *entity.ActionToken) error
+	VerifyEmail(ctx context.Context, hash string) (*entity.User, error)
+	ResetPassword(ctx context.Context, hash, password string) error
+
+	GetLoginThrottles(ctx context.Context, keys ...string) ([]entity.LoginThrottle, error)
+	RecordLoginFailure(ctx context.Context, key string, apply func(t *entity.LoginThrottle)) (*entity.LoginThrottle, error)
+	ResetLoginThrottle(ctx context.Context, key string) error
+	CreateAuditEntry(ctx context.Context, entry *entity.AuditEntry) error
+
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codes []entity.RecoveryCode) error
+	ListRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]entity.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, id uuid.UUID) (bool, error)
+
+	CreatePersonalToken(ctx context.Context, token *entity.PersonalToken) error
+	GetPersonalToken(ctx context.Context, hash string) (*entity.PersonalToken, error)
+	ListPersonalTokens(ctx context.Context, userID uuid.UUID) ([]entity.PersonalToken, error)
+	RevokePersonalToken(ctx context.Context, userID, tokenID uuid.UUID) error
+	TouchPersonalToken(ctx context.Context, tokenID uuid.UUID, at time.Time) error
+
+	CreateOIDCState(ctx context.Context, state *entity.OIDCState) error
+	ConsumeOIDCState(ctx context.Context, hash string) (*entity.OIDCState, error)
+	CreateIdentity(ctx context.Context, identity *entity.Identity) error
+	GetIdentity(ctx context.Context, provider, subject string) (*entity.Identity, error)
+	ListIdentities(ctx context.Context, userID uuid.UUID) ([]entity.Identity, error)
+	TouchIdentity(ctx context.Context, id uuid.UUID, at time.Time) error
+	DeleteIdentity(ctx context.Context, userID uuid.UUID, provider string) error
+
+	CreateFollow(ctx context.Context, follow *entity.Follow) error
+	DeleteFollow(ctx context.Context, follower, followee uuid.UUID) error
+	ListFollows(ctx context.Context, filter entity.FollowFilter, after *entity.FollowCursor, limit int) ([]entity.FollowedUser, error)
 }
 
 type UserCore struct {
-	repo    Repository
-	timeout time.Duration
-	cfg     *config.Config
+	repo      Repository
+	mailer    Mailer
+	providers map[string]IdentityProvider
+	keys      *authz.KeyRing
+	verifier  *authz.Verifier
+	timeout   time.Duration
+	cfg       *config.Config
 }
 
 type RefreshTokenClaims struct {
@@ -43,46 +114,121 @@ type RefreshTokenClaims struct {
 	jwt.RegisteredClaims
 }
 
+type refreshClaims struct {
+	userID    uuid.UUID
+	sessionID uuid.UUID
+	tokenID   uuid.UUID
+}
+
 func (uc *UserCore) context() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), uc.timeout)
 }
-func newJwtRefreshKey(uid, key string, dur time.Duration) (string, error) {
+func newJwtRefreshKey(uid, sid, jti string, keys *authz.KeyRing, dur time.Duration) (string, error) {
 	claims := jwt.MapClaims{
 		"uid": uid,
+		"sid": sid,
+		"jti": jti,
 		"exp": time.Now().Add(dur).Unix(),
 		"iat": time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	return token.SignedString([]byte(key))
+	return keys.Sign(claims)
 }
 
-func newJwtAccessKey(uid, ref, key string, dur time.Duration) (string, error) {
+// newJwtAccessKey signs an access token; ref references the session the
+// token was issued for.
+func newJwtAccessKey(uid, ref, role string, verified bool, keys *authz.KeyRing, dur time.Duration) (string, error) {
 	claims := jwt.MapClaims{
-		"uid": uid,
-		"ref": ref,
-		"exp": time.Now().Add(dur).Unix(),
-		"iat": time.Now().Unix(),
+		"uid":            uid,
+		"ref":            ref,
+		"role":           role,
+		"email_verified": verified,
+		"exp":            time.Now().Add(dur).Unix(),
+		"iat":            time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	return token.SignedString([]byte(key))
+	return keys.Sign(claims)
 }
 
-func NewUserCore(repo Repository, cfg *config.Config) *UserCore {
+// NewUserCore wires the core; providers are the OIDC providers keyed by
+// name and may be empty.
+func NewUserCore(repo Repository, mailer Mailer, keys *authz.KeyRing, providers map[string]IdentityProvider, cfg *config.Config) *UserCore {
 	return &UserCore{
-		repo: repo,
-		cfg:  cfg,
+		repo:      repo,
+		mailer:    mailer,
+		providers: providers,
+		keys:      keys,
+		verifier:  keys.Verifier(),
+		cfg:       cfg,
+		timeout:   cfg.RepoTimeout,
+	}
+}
+
+func (uc *UserCore) newTokenPair(user *entity.User, sid, jti string) (*entity.TokenPair, error) {
+	uid := user.ID.String()
+
+	reftoken, err := newJwtRefreshKey(uid, sid, jti, uc.keys, uc.cfg.RTokenTTL)
+	if err != nil {
+		return nil, ErrJwtFailed
+	}
+
+	access, err := newJwtAccessKey(uid, sid, user.Role, user.EmailVerified, uc.keys, uc.cfg.ATokenTTL)
+	if err != nil {
+		return nil, ErrJwtFailed
 	}
+
+	return &entity.TokenPair{
+		RefreshToken: reftoken,
+		AccessToken:  access,
+	}, nil
 }
 
-func (uc *UserCore) RegisterUser(username, password, email string) (*entity.TokenPair, error) {
-	if len(username) == 0 || len(password) == 0 {
+func (uc *UserCore) openSession(ctx context.Context, user *entity.User, client entity.ClientInfo) (*entity.TokenPair, error) {
+	session := entity.NewSession(user.ID, client, uc.cfg.RTokenTTL)
+	token := entity.NewRefreshToken(session.ID, uc.cfg.RTokenTTL)
+
+	if err := uc.repo.CreateSession(ctx, session, token); err != nil {
+		return nil, err
+	}
+
+	return uc.newTokenPair(user, session.ID.String(), token.ID.String())
+}
+
+func (uc *UserCore) parseRefreshToken(refreshToken string, opts ...jwt.ParserOption) (*refreshClaims, error) {
+	claims, err := uc.verifier.Parse(refreshToken, opts...)
+	if err != nil {
+		return nil, ErrParseToken
+	}
+
+	var parsed refreshClaims
+
+	for key, dst := range map[string]*uuid.UUID{
+		"uid": &parsed.userID,
+		"sid": &parsed.sessionID,
+		"jti": &parsed.tokenID,
+	} {
+		raw, _ := claims[key].(string)
+
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+
+		*dst = id
+	}
+
+	return &parsed, nil
+}
+
+func (uc *UserCore) RegisterUser(username, password, email string, client entity.ClientInfo) (*entity.TokenPair, error) {
+	if len(username) == 0 || len(password) == 0 || len(email) == 0 {
 		return nil, ErrEmptyFields
 	}
 
+	if !validEmail(email) {
+		return nil, ErrInvalidEmail
+	}
+
 	user := entity.NewUser(username, password, email)
 
 	ctx, cancel := uc.context()
@@ -92,46 +238,60 @@ func (uc *UserCore) RegisterUser(username, password, email string) (*entity.Toke
 		return nil, err
 	}
 
-	reftoken, err := newJwtRefreshKey(user.ID.String(), uc.cfg.JwtKey, uc.cfg.RTokenTTL)
+	tokens, err := uc.openSession(ctx, user, client)
 	if err != nil {
-		return nil, ErrJwtFailed
+		return nil, err
 	}
 
-	access, err := newJwtAccessKey(user.ID.String(), reftoken, uc.cfg.JwtKey, uc.cfg.ATokenTTL)
-	if err != nil {
-		return nil, ErrJwtFailed
-	}
+	// a failed delivery must not fail the registration, the user can ask
+	// for another mail through SendVerificationEmail
+	_ = uc.sendVerification(ctx, user)
 
-	return &entity.TokenPair{
-		RefreshToken: reftoken,
-		AccessToken:  access,
-	}, nil
+	return tokens, nil
 }
 
-func (uc *UserCore) LoginUser(password, email string) (*entity.TokenPair, error) {
+// LoginUser checks the password. Accounts with two-factor authentication
+// get an mfa ticket for VerifyMFA instead of tokens.
+func (uc *UserCore) LoginUser(password, email string, client entity.ClientInfo) (*entity.TokenPair, string, error) {
 	if len(password) == 0 || len(email) == 0 {
-		return nil, ErrEmptyFields
+		return nil, "", ErrEmptyFields
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), uc.timeout)
+	ctx, cancel := uc.context()
 	defer cancel()
 
-	id, err := uc.repo.CheckUser(ctx, password, email)
-	if err != nil {
-		return nil, err
+	if err := uc.checkLoginThrottle(ctx, email, client); err != nil {
+		return nil, "", err
 	}
 
-	reftoken, err := newJwtRefreshKey(id, uc.cfg.JwtKey, uc.cfg.RTokenTTL)
+	user, err := uc.repo.CheckUser(ctx, email, password)
 	if err != nil {
-		return nil, err
+		if !errors.Is(err, repository.ErrNotFound) {
+			return nil, "", err
+		}
+
+		return nil, "", uc.loginFailed(ctx, email, client)
 	}
 
-	access, err := newJwtAccessKey(id, reftoken, uc.cfg.JwtKey, uc.cfg.ATokenTTL)
+	if user.TOTPEnabled {
+		ticket, err := newMFATicket(user.ID.String(), uc.keys, uc.cfg.MFA.TicketTTL)
+		if err != nil {
+			return nil, "", ErrJwtFailed
+		}
 
-	return &entity.TokenPair{
-		RefreshToken: reftoken,
-		AccessToken:  access,
-	}, nil
+		return nil, ticket, nil
+	}
+
+	if err = uc.repo.ResetLoginThrottle(ctx, accountThrottleKey(email)); err != nil {
+		return nil, "", err
+	}
+
+	tokens, err := uc.openSession(ctx, user, client)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return tokens, "", nil
 }
 
 func (uc *UserCore) ChangePassword(id uuid.UUID, old, new string) error {
@@ -166,50 +326,99 @@ func (uc *UserCore) ChangePassword(id uuid.UUID, old, new string) error {
 	return nil
 }
 
-func (uc *UserCore) Refresh(refreshToken string) (string, error) {
+// Refresh rotates the refresh token. A token that was already rotated is
+// treated as stolen and revokes the session it belongs to.
+func (uc *UserCore) Refresh(refreshToken string) (*entity.TokenPair, error) {
 	if len(refreshToken) == 0 {
-		return "", ErrEmptyFields
+		return nil, ErrEmptyFields
 	}
 
-	token, err := jwt.Parse(refreshToken, func(t *jwt.Token) (any, error) {
-		return uc.cfg.JwtKey, nil
-	})
+	claims, err := uc.parseRefreshToken(refreshToken)
 	if err != nil {
-		return "", ErrParseToken
+		return nil, err
 	}
 
-	refreshClaims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || !token.Valid {
-		return "", ErrInvalidToken
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	token, err := uc.repo.GetRefreshToken(ctx, claims.tokenID)
+	if err != nil {
+		return nil, ErrInvalidToken
 	}
 
-	access, err := newJwtAccessKey(refreshClaims["uid"].(string), refreshToken, uc.cfg.JwtKey, uc.cfg.ATokenTTL)
+	session, err := uc.repo.GetSession(ctx, token.SessionID)
 	if err != nil {
-		return "", err
+		return nil, ErrInvalidToken
 	}
 
-	return access, nil
+	if session.RevokedAt != nil {
+		return nil, ErrSessionRevoked
+	}
+
+	if session.ID != claims.sessionID || session.UserID != claims.userID {
+		return nil, ErrInvalidToken
+	}
+
+	next := entity.NewRefreshToken(session.ID, uc.cfg.RTokenTTL)
+
+	rotated := false
+	if token.UsedAt == nil {
+		rotated, err = uc.repo.RotateRefreshToken(ctx, token.ID, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !rotated {
+		if err = uc.repo.RevokeSession(ctx, session.UserID, session.ID); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrTokenReused
+	}
+
+	// the role is read again so that promotions apply on the next refresh
+	user, err := uc.repo.GetUser(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.newTokenPair(user, session.ID.String(), next.ID.String())
 }
 
-func (uc *UserCore) IncLike(uid uuid.UUID) error {
-	if len(uid) == 0 {
+// Logout revokes the session of the given refresh token. Expired tokens are
+// accepted so that a stale cookie can still end its session.
+func (uc *UserCore) Logout(refreshToken string) error {
+	if len(refreshToken) == 0 {
 		return ErrEmptyFields
 	}
 
-	ctx, cancel := uc.context()
-	defer cancel()
-
-	user, err := uc.repo.GetUser(ctx, uid)
+	claims, err := uc.parseRefreshToken(refreshToken, jwt.WithoutClaimsValidation())
 	if err != nil {
 		return err
 	}
 
-	user.Likes++
+	ctx, cancel := uc.context()
+	defer cancel()
 
-	return uc.repo.UpdateUser(ctx, user)
+	return uc.repo.RevokeSession(ctx, claims.userID, claims.sessionID)
 }
 
-func (uc *UserCore) DecLike(uid uuid.UUID) error {
+func (uc *UserCore) ListSessions(uid uuid.UUID) ([]entity.Session, error) {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.ListSessions(ctx, uid)
+}
+
+func (uc *UserCore) RevokeSession(uid, sessionID uuid.UUID) error {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.RevokeSession(ctx, uid, sessionID)
+}
+
+func (uc *UserCore) IncLike(uid uuid.UUID) error {
 	if len(uid) == 0 {
 		return ErrEmptyFields
 	}
@@ -217,14 +426,18 @@ func (uc *UserCore) DecLike(uid uuid.UUID) error {
 	ctx, cancel := uc.context()
 	defer cancel()
 
-	user, err := uc.repo.GetUser(ctx, uid)
-	if err != nil {
-		return err
+	return uc.repo.AddLikes(ctx, uid, 1)
+}
+
+func (uc *UserCore) DecLike(uid uuid.UUID) error {
+	if len(uid) == 0 {
+		return ErrEmptyFields
 	}
 
-	user.Likes--
+	ctx, cancel := uc.context()
+	defer cancel()
 
-	return uc.repo.UpdateUser(ctx, user)
+	return uc.repo.AddLikes(ctx, uid, -1)
 }
 
 func (uc *UserCore) IncReview(uid uuid.UUID) error {
@@ -235,14 +448,7 @@ func (uc *UserCore) IncReview(uid uuid.UUID) error {
 	ctx, cancel := uc.context()
 	defer cancel()
 
-	user, err := uc.repo.GetUser(ctx, uid)
-	if err != nil {
-		return err
-	}
-
-	user.Likes--
-
-	return uc.repo.UpdateUser(ctx, user)
+	return uc.repo.AddReviews(ctx, uid, 1)
 }
 
 func (uc *UserCore) DecReview(uid uuid.UUID) error {
@@ -253,14 +459,7 @@ func (uc *UserCore) DecReview(uid uuid.UUID) error {
 	ctx, cancel := uc.context()
 	defer cancel()
 
-	user, err := uc.repo.GetUser(ctx, uid)
-	if err != nil {
-		return err
-	}
-
-	user.Reviews++
-
-	return uc.repo.UpdateUser(ctx, user)
+	return uc.repo.AddReviews(ctx, uid, -1)
 }
 
 func (uc *UserCore) GetUserByID(uid uuid.UUID) (*entity.User, error) {
@@ -295,3 +494,295 @@ func (uc *UserCore) DeleteUser(uid uuid.UUID) error {
 
 	return uc.repo.DeleteUser(ctx, uid)
 }
+
+func (uc *UserCore) SetUserRole(uid uuid.UUID, role string) (*entity.User, error) {
+	parsed, err := authz.ParseRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Role = string(parsed)
+
+	if err = uc.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// BootstrapAdmin makes sure an admin exists. The configured account is
+// created, or promoted if it is already registered, only while the
+// service has no admin at all. A registered account is only promoted once
+// its email is verified and its password is the configured one, so that
+// nobody becomes admin by signing up with the address first.
+func (uc *UserCore) BootstrapAdmin() error {
+	admin := uc.cfg.BootstrapAdmin
+	if admin.Email == "" {
+		return nil
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	count, err := uc.repo.CountUsersByRole(ctx, string(authz.RoleAdmin))
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	user, err := uc.repo.GetUserByEmail(ctx, admin.Email)
+	if err == nil {
+		if !user.EmailVerified || bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(admin.Password)) != nil {
+			return ErrBootstrapAccount
+		}
+
+		user.Role = string(authz.RoleAdmin)
+
+		return uc.repo.UpdateUser(ctx, user)
+	}
+
+	if !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	if len(admin.Username) == 0 || len(admin.Password) == 0 {
+		return ErrEmptyFields
+	}
+
+	user = entity.NewUser(admin.Username, admin.Password, admin.Email)
+	user.Role = string(authz.RoleAdmin)
+	user.EmailVerified = true
+
+	return uc.repo.CreateUser(ctx, user)
+}
+
+// UpdateUser applies the fields named in paths from patch to the user.
+func (uc *UserCore) UpdateUser(uid uuid.UUID, patch *entity.User, paths []string) (*entity.User, error) {
+	if patch == nil || len(paths) == 0 {
+		return nil, ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	emailChanged := false
+
+	for _, path := range paths {
+		switch path {
+		case "username":
+			if len(patch.Username) == 0 {
+				return nil, ErrEmptyFields
+			}
+
+			if patch.Username == user.Username {
+				continue
+			}
+
+			if _, err = uc.repo.GetUserByUsername(ctx, patch.Username); err == nil {
+				return nil, ErrUsernameTaken
+			}
+
+			user.Username = patch.Username
+		case "email":
+			if patch.Email == user.Email {
+				continue
+			}
+
+			if err = uc.setEmail(ctx, user, patch.Email); err != nil {
+				return nil, err
+			}
+
+			emailChanged = true
+		case "display_name":
+			if len(patch.DisplayName) > maxDisplayNameLen {
+				return nil, ErrInvalidProfile
+			}
+
+			user.DisplayName = patch.DisplayName
+		case "bio":
+			if len(patch.Bio) > maxBioLen {
+				return nil, ErrInvalidProfile
+			}
+
+			user.Bio = patch.Bio
+		case "avatar_url":
+			if len(patch.AvatarURL) > 0 && !validAvatarURL(patch.AvatarURL) {
+				return nil, ErrInvalidProfile
+			}
+
+			user.AvatarURL = patch.AvatarURL
+		default:
+			return nil, ErrUnknownField
+		}
+	}
+
+	if err = uc.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if emailChanged {
+		_ = uc.sendVerification(ctx, user)
+	}
+
+	return user, nil
+}
+
+// setEmail puts an unverified email in place of the user's one.
+func (uc *UserCore) setEmail(ctx context.Context, user *entity.User, email string) error {
+	if len(email) == 0 {
+		return ErrEmptyFields
+	}
+
+	if !validEmail(email) {
+		return ErrInvalidEmail
+	}
+
+	if _, err := uc.repo.GetUserByEmail(ctx, email); err == nil {
+		return ErrEmailTaken
+	}
+
+	user.Email = email
+	user.EmailVerified = false
+
+	return nil
+}
+
+// ChangeEmail replaces the email of the user, who proves to own the account
+// with the current password: password resets go to the new email.
+func (uc *UserCore) ChangeEmail(id uuid.UUID, password, email string) (*entity.User, error) {
+	if len(password) == 0 || len(email) == 0 {
+		return nil, ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return nil, ErrWrongPassword
+	}
+
+	if email == user.Email {
+		return user, nil
+	}
+
+	if err = uc.setEmail(ctx, user, email); err != nil {
+		return nil, err
+	}
+
+	if err = uc.repo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	_ = uc.sendVerification(ctx, user)
+
+	return user, nil
+}
+
+func validAvatarURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// ParseUserOrder accepts "created_at", "reviews" or "likes" with an
+// optional " desc" suffix. An empty value lists the newest users first.
+func ParseUserOrder(orderBy string) (entity.UserOrder, bool, error) {
+	fields := strings.Fields(orderBy)
+
+	switch len(fields) {
+	case 0:
+		return entity.UserOrderCreatedAt, true, nil
+	case 1, 2:
+	default:
+		return "", false, ErrInvalidOrder
+	}
+
+	desc := false
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "desc":
+			desc = true
+		case "asc":
+		default:
+			return "", false, ErrInvalidOrder
+		}
+	}
+
+	switch order := entity.UserOrder(fields[0]); order {
+	case entity.UserOrderCreatedAt, entity.UserOrderReviews, entity.UserOrderLikes:
+		return order, desc, nil
+	default:
+		return "", false, ErrInvalidOrder
+	}
+}
+
+// ListUsers returns one page of users, the token of the next page (empty on
+// the last page) and the number of users matching the filter.
+func (uc *UserCore) ListUsers(filter entity.UserFilter, pageSize int, pageToken string) ([]entity.User, string, int64, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.UserCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodePageToken(pageToken, filter)
+		if err != nil {
+			return nil, "", 0, err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	// one extra row tells whether another page exists
+	users, err := uc.repo.ListUsers(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	total, err := uc.repo.CountUsers(ctx, filter)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	next := ""
+	if len(users) > pageSize {
+		users = users[:pageSize]
+
+		if next, err = encodePageToken(filter, &users[pageSize-1]); err != nil {
+			return nil, "", 0, ErrInternal
+		}
+	}
+
+	return users, next, total, nil
+}
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+)
+
+var ErrFollowSelf = errors.New("users cannot follow themselves")
+
+func (uc *UserCore) Follow(follower, followee uuid.UUID) error {
+	if follower == uuid.Nil || followee == uuid.Nil {
+		return ErrEmptyFields
+	}
+
+	if follower == followee {
+		return ErrFollowSelf
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	if _, err := uc.repo.GetUser(ctx, followee); err != nil {
+		return err
+	}
+
+	return uc.repo.CreateFollow(ctx, entity.NewFollow(follower, followee))
+}
+
+func (uc *UserCore) Unfollow(follower, followee uuid.UUID) error {
+	if follower == uuid.Nil || followee == uuid.Nil {
+		return ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.DeleteFollow(ctx, follower, followee)
+}
+
+// ListFollowers returns one page of the users following uid, most recent
+// first, and the token of the next page (empty on the last page).
+func (uc *UserCore) ListFollowers(uid uuid.UUID, pageSize int, pageToken string) ([]entity.User, string, error) {
+	return uc.listFollows(entity.FollowFilter{
+		UserID:    uid,
+		Direction: entity.FollowDirectionFollowers,
+	}, pageSize, pageToken)
+}
+
+// ListFollowing is ListFollowers for the users uid follows.
+func (uc *UserCore) ListFollowing(uid uuid.UUID, pageSize int, pageToken string) ([]entity.User, string, error) {
+	return uc.listFollows(entity.FollowFilter{
+		UserID:    uid,
+		Direction: entity.FollowDirectionFollowing,
+	}, pageSize, pageToken)
+}
+
+func (uc *UserCore) listFollows(filter entity.FollowFilter, pageSize int, pageToken string) ([]entity.User, string, error) {
+	if filter.UserID == uuid.Nil {
+		return nil, "", ErrEmptyFields
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	var after *entity.FollowCursor
+
+	if len(pageToken) > 0 {
+		cursor, err := decodeFollowPageToken(pageToken, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	if _, err := uc.repo.GetUser(ctx, filter.UserID); err != nil {
+		return nil, "", err
+	}
+
+	// one extra row tells whether another page exists
+	follows, err := uc.repo.ListFollows(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(follows) > pageSize {
+		follows = follows[:pageSize]
+
+		if next, err = encodeFollowPageToken(filter, &follows[pageSize-1]); err != nil {
+			return nil, "", ErrInternal
+		}
+	}
+
+	users := make([]entity.User, len(follows))
+	for i := range follows {
+		users[i] = follows[i].User
+	}
+
+	return users, next, nil
+}
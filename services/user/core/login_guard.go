@@ -0,0 +1,180 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrAccountLocked      = errors.New("account is temporarily locked")
+	ErrTooManyAttempts    = errors.New("too many login attempts")
+)
+
+// RetryError tells the caller when the next login attempt is accepted.
+type RetryError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s, retry in %s", e.Err, (e.RetryAfter + time.Second - 1).Truncate(time.Second))
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+func accountThrottleKey(email string) string {
+	return entity.ThrottleAccountPrefix + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipThrottleKey(ip string) string {
+	return entity.ThrottleIPPrefix + ip
+}
+
+// backoff is the delay enforced after the given number of consecutive
+// failures on an account.
+func (uc *UserCore) backoff(failures int) time.Duration {
+	guard := uc.cfg.LoginGuard
+
+	delay := guard.BackoffBase
+	for i := 1; i < failures && delay < guard.BackoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, guard.BackoffMax)
+}
+
+// checkLoginThrottle rejects the attempt before the password is checked
+// when the account or the address is locked or still backing off.
+func (uc *UserCore) checkLoginThrottle(ctx context.Context, email string, client entity.ClientInfo) error {
+	keys := []string{accountThrottleKey(email)}
+	if len(client.IPAddress) > 0 {
+		keys = append(keys, ipThrottleKey(client.IPAddress))
+	}
+
+	throttles, err := uc.repo.GetLoginThrottles(ctx, keys...)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, throttle := range throttles {
+		account := strings.HasPrefix(throttle.Key, entity.ThrottleAccountPrefix)
+
+		if throttle.Locked(now) {
+			retry := throttle.LockedUntil.Sub(now)
+
+			if account {
+				return &RetryError{Err: ErrAccountLocked, RetryAfter: retry}
+			}
+
+			return &RetryError{Err: ErrTooManyAttempts, RetryAfter: retry}
+		}
+
+		if !account || throttle.Failures == 0 || now.Sub(throttle.LastFailureAt) > uc.cfg.LoginGuard.FailureWindow {
+			continue
+		}
+
+		if next := throttle.LastFailureAt.Add(uc.backoff(throttle.Failures)); now.Before(next) {
+			return &RetryError{Err: ErrTooManyAttempts, RetryAfter: next.Sub(now)}
+		}
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts the failure for the key and locks it once
+// limit failures happened within the window. It reports the lock.
+func (uc *UserCore) recordLoginFailure(ctx context.Context, key string, limit int) (*entity.LoginThrottle, bool, error) {
+	guard := uc.cfg.LoginGuard
+	now := time.Now()
+	locked := false
+
+	throttle, err := uc.repo.RecordLoginFailure(ctx, key, func(t *entity.LoginThrottle) {
+		if t.LockedUntil != nil && !t.Locked(now) {
+			t.LockedUntil = nil
+			t.Failures = 0
+		}
+
+		if now.Sub(t.LastFailureAt) > guard.FailureWindow {
+			t.Failures = 0
+		}
+
+		t.Failures++
+		t.LastFailureAt = now
+
+		if t.Failures >= limit {
+			until := now.Add(guard.LockoutDuration)
+			t.LockedUntil = &until
+			locked = true
+		}
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return throttle, locked, nil
+}
+
+// loginFailed records a failed attempt against the account and the client
+// address and audits the locks it causes.
+func (uc *UserCore) loginFailed(ctx context.Context, email string, client entity.ClientInfo) error {
+	guard := uc.cfg.LoginGuard
+
+	throttle, locked, err := uc.recordLoginFailure(ctx, accountThrottleKey(email), guard.MaxAccountFailures)
+	if err != nil {
+		return err
+	}
+
+	var lockErr error
+
+	if locked {
+		if err = uc.audit(ctx, entity.AuditAccountLocked, email, client, throttle); err != nil {
+			return err
+		}
+
+		lockErr = &RetryError{Err: ErrAccountLocked, RetryAfter: guard.LockoutDuration}
+	}
+
+	if len(client.IPAddress) > 0 {
+		throttle, locked, err = uc.recordLoginFailure(ctx, ipThrottleKey(client.IPAddress), guard.MaxIPFailures)
+		if err != nil {
+			return err
+		}
+
+		if locked {
+			if err = uc.audit(ctx, entity.AuditIPLocked, client.IPAddress, client, throttle); err != nil {
+				return err
+			}
+
+			if lockErr == nil {
+				lockErr = &RetryError{Err: ErrTooManyAttempts, RetryAfter: guard.LockoutDuration}
+			}
+		}
+	}
+
+	if lockErr != nil {
+		return lockErr
+	}
+
+	return ErrInvalidCredentials
+}
+
+func (uc *UserCore) audit(ctx context.Context, event entity.AuditEvent, subject string, client entity.ClientInfo, throttle *entity.LoginThrottle) error {
+	return uc.repo.CreateAuditEntry(ctx, &entity.AuditEntry{
+		Event:     event,
+		Subject:   subject,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Detail: fmt.Sprintf("locked after %d failed logins until %s",
+			throttle.Failures, throttle.LockedUntil.Format(time.RFC3339)),
+	})
+}
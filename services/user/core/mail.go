@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidEmail    = errors.New("invalid email")
+	ErrAlreadyVerified = errors.New("email is already verified")
+	ErrMailFailed      = errors.New("failed to send mail")
+)
+
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+func validEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+
+	return err == nil && addr.Address == email
+}
+
+func (uc *UserCore) link(path, token string) string {
+	return strings.TrimRight(uc.cfg.Mailer.LinkBaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func (uc *UserCore) sendVerification(ctx context.Context, user *entity.User) error {
+	token, raw, err := entity.NewActionToken(user.ID, entity.PurposeVerifyEmail, user.Email, uc.cfg.VerifyTTL)
+	if err != nil {
+		return ErrInternal
+	}
+
+	if err = uc.repo.CreateActionToken(ctx, token); err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("Hi %s,\n\nconfirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+		user.Username, uc.link("/verify-email", raw), uc.cfg.VerifyTTL)
+
+	if err = uc.mailer.Send(ctx, user.Email, "Confirm your email", body); err != nil {
+		return ErrMailFailed
+	}
+
+	return nil
+}
+
+// SendVerificationEmail mails a new verification link; links mailed
+// earlier stop working.
+func (uc *UserCore) SendVerificationEmail(uid uuid.UUID) error {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if len(user.Email) == 0 {
+		return ErrEmptyFields
+	}
+
+	if user.EmailVerified {
+		return ErrAlreadyVerified
+	}
+
+	return uc.sendVerification(ctx, user)
+}
+
+func (uc *UserCore) VerifyEmail(token string) (*entity.User, error) {
+	if len(token) == 0 {
+		return nil, ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.VerifyEmail(ctx, entity.HashActionToken(token))
+}
+
+// RequestPasswordReset mails a reset link when the email belongs to an
+// account. It reports success either way so that callers cannot probe
+// which addresses are registered.
+func (uc *UserCore) RequestPasswordReset(email string) error {
+	if len(email) == 0 {
+		return ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+
+	token, raw, err := entity.NewActionToken(user.ID, entity.PurposeResetPassword, user.Email, uc.cfg.ResetTTL)
+	if err != nil {
+		return ErrInternal
+	}
+
+	if err = uc.repo.CreateActionToken(ctx, token); err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("Hi %s,\n\nsomeone asked to reset the password of your account. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If it was not you, ignore this mail.\n",
+		user.Username, uc.link("/reset-password", raw), uc.cfg.ResetTTL)
+
+	// the mailer logs delivery failures, the caller gets no hint either way
+	_ = uc.mailer.Send(ctx, user.Email, "Reset your password", body)
+
+	return nil
+}
+
+// ResetPassword sets a new password and signs the user out everywhere.
+func (uc *UserCore) ResetPassword(token, password string) error {
+	if len(token) == 0 || len(password) == 0 {
+		return ErrEmptyFields
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return ErrInternal
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.ResetPassword(ctx, entity.HashActionToken(token), string(hash))
+}
@@ -0,0 +1,251 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/totp"
+)
+
+var (
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnrolled    = errors.New("two-factor authentication is not enrolled")
+	ErrMFANotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrInvalidMFACode    = errors.New("invalid two-factor code")
+	ErrInvalidMFATicket  = errors.New("invalid or expired mfa ticket")
+)
+
+const mfaTicketKind = "totp"
+
+// newMFATicket signs the ticket handed out between the password and the
+// code step. It has neither a "ref" nor a "sid" claim, so it is accepted
+// neither as an access nor as a refresh token.
+func newMFATicket(uid string, keys *authz.KeyRing, dur time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"uid": uid,
+		"mfa": mfaTicketKind,
+		"exp": time.Now().Add(dur).Unix(),
+		"iat": time.Now().Unix(),
+	}
+
+	return keys.Sign(claims)
+}
+
+func (uc *UserCore) parseMFATicket(ticket string) (uuid.UUID, error) {
+	claims, err := uc.verifier.Parse(ticket, jwt.WithExpirationRequired())
+	if err != nil || claims["mfa"] != mfaTicketKind {
+		return uuid.Nil, ErrInvalidMFATicket
+	}
+
+	raw, _ := claims["uid"].(string)
+
+	uid, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, ErrInvalidMFATicket
+	}
+
+	return uid, nil
+}
+
+// EnrollTOTP starts enrollment with a new secret and recovery codes. The
+// secret takes effect once ConfirmTOTP accepted a code generated from it.
+func (uc *UserCore) EnrollTOTP(uid uuid.UUID) (string, string, []string, error) {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	if user.TOTPEnabled {
+		return "", "", nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", nil, ErrInternal
+	}
+
+	codes, plain, err := entity.NewRecoveryCodes(user.ID)
+	if err != nil {
+		return "", "", nil, ErrInternal
+	}
+
+	user.TOTPSecret = secret
+	user.TOTPLastStep = 0
+
+	if err = uc.repo.UpdateUser(ctx, user); err != nil {
+		return "", "", nil, err
+	}
+
+	if err = uc.repo.ReplaceRecoveryCodes(ctx, user.ID, codes); err != nil {
+		return "", "", nil, err
+	}
+
+	account := user.Email
+	if len(account) == 0 {
+		account = user.Username
+	}
+
+	return secret, totp.ProvisioningURI(uc.cfg.MFA.Issuer, account, secret), plain, nil
+}
+
+func (uc *UserCore) ConfirmTOTP(uid uuid.UUID, code string) error {
+	if len(code) == 0 {
+		return ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if user.TOTPEnabled {
+		return ErrMFAAlreadyEnabled
+	}
+
+	if len(user.TOTPSecret) == 0 {
+		return ErrMFANotEnrolled
+	}
+
+	step, ok := totp.Validate(user.TOTPSecret, code, time.Now(), user.TOTPLastStep)
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	user.TOTPEnabled = true
+	user.TOTPLastStep = step
+
+	return uc.repo.UpdateUser(ctx, user)
+}
+
+// DisableTOTP turns two-factor authentication off after checking a code.
+func (uc *UserCore) DisableTOTP(uid uuid.UUID, code string) error {
+	if len(code) == 0 {
+		return ErrEmptyFields
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if !user.TOTPEnabled {
+		return ErrMFANotEnabled
+	}
+
+	if err = uc.verifySecondFactor(ctx, user, code); err != nil {
+		return err
+	}
+
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+
+	if err = uc.repo.UpdateUser(ctx, user); err != nil {
+		return err
+	}
+
+	return uc.repo.ReplaceRecoveryCodes(ctx, user.ID, nil)
+}
+
+// VerifyMFA completes a login that returned a ticket. Wrong codes count as
+// failed logins of the account.
+func (uc *UserCore) VerifyMFA(ticket, code string, client entity.ClientInfo) (*entity.TokenPair, error) {
+	if len(ticket) == 0 || len(code) == 0 {
+		return nil, ErrEmptyFields
+	}
+
+	uid, err := uc.parseMFATicket(ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil || !user.TOTPEnabled {
+		return nil, ErrInvalidMFATicket
+	}
+
+	if err = uc.checkLoginThrottle(ctx, user.Email, client); err != nil {
+		return nil, err
+	}
+
+	if err = uc.verifySecondFactor(ctx, user, code); err != nil {
+		if !errors.Is(err, ErrInvalidMFACode) {
+			return nil, err
+		}
+
+		if err = uc.loginFailed(ctx, user.Email, client); errors.Is(err, ErrInvalidCredentials) {
+			return nil, ErrInvalidMFACode
+		}
+
+		return nil, err
+	}
+
+	if err = uc.repo.ResetLoginThrottle(ctx, accountThrottleKey(user.Email)); err != nil {
+		return nil, err
+	}
+
+	return uc.openSession(ctx, user, client)
+}
+
+// verifySecondFactor accepts a current TOTP code that was not used before
+// or an unused recovery code, and consumes it.
+func (uc *UserCore) verifySecondFactor(ctx context.Context, user *entity.User, code string) error {
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(user.TOTPSecret, code, time.Now(), user.TOTPLastStep)
+		if !ok {
+			return ErrInvalidMFACode
+		}
+
+		used, err := uc.repo.UseTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return err
+		}
+
+		if !used {
+			return ErrInvalidMFACode
+		}
+
+		user.TOTPLastStep = step
+
+		return nil
+	}
+
+	codes, err := uc.repo.ListRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, recovery := range codes {
+		if !recovery.Matches(code) {
+			continue
+		}
+
+		used, err := uc.repo.UseRecoveryCode(ctx, recovery.ID)
+		if err != nil {
+			return err
+		}
+
+		if used {
+			return nil
+		}
+	}
+
+	return ErrInvalidMFACode
+}
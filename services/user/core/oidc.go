@@ -0,0 +1,311 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/oidc"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
+)
+
+var (
+	ErrUnknownProvider       = errors.New("unknown identity provider")
+	ErrInvalidOIDCState      = errors.New("invalid or expired oidc state")
+	ErrOIDCFailed            = errors.New("identity provider rejected the login")
+	ErrIdentityLinked        = errors.New("identity is linked to another account")
+	ErrProviderAlreadyLinked = errors.New("provider is already linked to this account")
+	ErrOIDCEmailRequired     = errors.New("identity provider did not return a verified email")
+	ErrOIDCEmailTaken        = errors.New("an account with this email exists, sign in and link the provider")
+	ErrLastLoginMethod       = errors.New("cannot unlink the only way to sign in, set a password first")
+)
+
+const (
+	minUsernameLen      = 3
+	maxGeneratedNameLen = 40
+	usernameAttempts    = 5
+)
+
+// IdentityProvider is the relying-party side of one OIDC provider.
+type IdentityProvider interface {
+	AuthCodeURL(state, nonce, verifier string) string
+	Exchange(ctx context.Context, code, verifier, nonce string) (*oidc.Claims, error)
+}
+
+// BeginOIDC returns the authorization URL of the provider and the state
+// sent along. With a user id the flow links the provider to that user
+// instead of signing in.
+func (uc *UserCore) BeginOIDC(provider string, uid *uuid.UUID) (string, string, error) {
+	idp, ok := uc.providers[provider]
+	if !ok {
+		return "", "", ErrUnknownProvider
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	if uid != nil {
+		identities, err := uc.repo.ListIdentities(ctx, *uid)
+		if err != nil {
+			return "", "", err
+		}
+
+		for _, identity := range identities {
+			if identity.Provider == provider {
+				return "", "", ErrProviderAlreadyLinked
+			}
+		}
+	}
+
+	verifier, err := oidc.NewVerifier()
+	if err != nil {
+		return "", "", ErrInternal
+	}
+
+	state, raw, err := entity.NewOIDCState(provider, uid, verifier, uc.cfg.OIDC.StateTTL)
+	if err != nil {
+		return "", "", ErrInternal
+	}
+
+	if err = uc.repo.CreateOIDCState(ctx, state); err != nil {
+		return "", "", err
+	}
+
+	return idp.AuthCodeURL(raw, state.Nonce, verifier), raw, nil
+}
+
+// CompleteOIDC finishes a flow started by BeginOIDC. A sign-in returns
+// tokens, or an mfa ticket like LoginUser; a link returns the identity.
+// Unknown identities sign up a new user when the provider vouches for an
+// email that is not registered yet.
+func (uc *UserCore) CompleteOIDC(provider, rawState, code string, client entity.ClientInfo) (*entity.TokenPair, string, *entity.Identity, error) {
+	if len(rawState) == 0 || len(code) == 0 {
+		return nil, "", nil, ErrEmptyFields
+	}
+
+	idp, ok := uc.providers[provider]
+	if !ok {
+		return nil, "", nil, ErrUnknownProvider
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	state, err := uc.repo.ConsumeOIDCState(ctx, entity.HashActionToken(rawState))
+	if err != nil {
+		if errors.Is(err, repository.ErrOIDCStateNotFound) {
+			return nil, "", nil, ErrInvalidOIDCState
+		}
+
+		return nil, "", nil, err
+	}
+
+	if state.Provider != provider {
+		return nil, "", nil, ErrInvalidOIDCState
+	}
+
+	claims, err := idp.Exchange(ctx, code, state.Verifier, state.Nonce)
+	if err != nil {
+		return nil, "", nil, ErrOIDCFailed
+	}
+
+	if state.UserID != nil {
+		identity, err := uc.linkIdentity(ctx, *state.UserID, provider, claims)
+
+		return nil, "", identity, err
+	}
+
+	identity, err := uc.repo.GetIdentity(ctx, provider, claims.Subject)
+
+	var user *entity.User
+
+	switch {
+	case err == nil:
+		if user, err = uc.repo.GetUser(ctx, identity.UserID); err != nil {
+			return nil, "", nil, err
+		}
+
+		// a missed update only makes last_login_at stale
+		_ = uc.repo.TouchIdentity(ctx, identity.ID, time.Now())
+	case errors.Is(err, repository.ErrIdentityNotFound):
+		if user, err = uc.signUpWithIdentity(ctx, provider, claims); err != nil {
+			return nil, "", nil, err
+		}
+	default:
+		return nil, "", nil, err
+	}
+
+	if user.TOTPEnabled {
+		ticket, err := newMFATicket(user.ID.String(), uc.keys, uc.cfg.MFA.TicketTTL)
+		if err != nil {
+			return nil, "", nil, ErrJwtFailed
+		}
+
+		return nil, ticket, nil, nil
+	}
+
+	tokens, err := uc.openSession(ctx, user, client)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return tokens, "", nil, nil
+}
+
+func (uc *UserCore) linkIdentity(ctx context.Context, uid uuid.UUID, provider string, claims *oidc.Claims) (*entity.Identity, error) {
+	existing, err := uc.repo.GetIdentity(ctx, provider, claims.Subject)
+	if err == nil {
+		if existing.UserID != uid {
+			return nil, ErrIdentityLinked
+		}
+
+		return existing, nil
+	}
+
+	if !errors.Is(err, repository.ErrIdentityNotFound) {
+		return nil, err
+	}
+
+	identities, err := uc.repo.ListIdentities(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			return nil, ErrProviderAlreadyLinked
+		}
+	}
+
+	identity := entity.NewIdentity(uid, provider, claims.Subject, claims.Email)
+
+	if err = uc.repo.CreateIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// signUpWithIdentity creates a user without a password. Matching an
+// existing account by email is refused rather than linked: only the
+// account owner may link a provider.
+func (uc *UserCore) signUpWithIdentity(ctx context.Context, provider string, claims *oidc.Claims) (*entity.User, error) {
+	if !claims.EmailVerified || !validEmail(claims.Email) {
+		return nil, ErrOIDCEmailRequired
+	}
+
+	if _, err := uc.repo.GetUserByEmail(ctx, claims.Email); err == nil {
+		return nil, ErrOIDCEmailTaken
+	}
+
+	username, err := uc.freeUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	user := entity.NewUser(username, "", claims.Email)
+	user.EmailVerified = true
+
+	if err = uc.repo.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	identity := entity.NewIdentity(user.ID, provider, claims.Subject, claims.Email)
+	now := time.Now()
+	identity.LastLoginAt = &now
+
+	if err = uc.repo.CreateIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// freeUsername derives a username from the provider claims and appends
+// random digits while it is taken.
+func (uc *UserCore) freeUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	base = strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+
+		return -1
+	}, base)
+
+	if len(base) > maxGeneratedNameLen {
+		base = base[:maxGeneratedNameLen]
+	}
+
+	if len(base) < minUsernameLen {
+		base = "user"
+	}
+
+	candidate := base
+
+	for range usernameAttempts {
+		if _, err := uc.repo.GetUserByUsername(ctx, candidate); errors.Is(err, repository.ErrNotFound) {
+			return candidate, nil
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			return "", ErrInternal
+		}
+
+		candidate = base + n.String()
+	}
+
+	return "", ErrUsernameTaken
+}
+
+func (uc *UserCore) ListIdentities(uid uuid.UUID) ([]entity.Identity, error) {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.ListIdentities(ctx, uid)
+}
+
+// UnlinkIdentity refuses to remove the last identity of a user without a
+// password, who could not sign in anymore.
+func (uc *UserCore) UnlinkIdentity(uid uuid.UUID, provider string) error {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	identities, err := uc.repo.ListIdentities(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	linked := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			linked = true
+		}
+	}
+
+	if !linked {
+		return repository.ErrIdentityNotFound
+	}
+
+	if user.Password == "" && len(identities) == 1 {
+		return ErrLastLoginMethod
+	}
+
+	return uc.repo.DeleteIdentity(ctx, uid, provider)
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+)
+
+// pageToken is handed out base64 encoded. It carries the filter it was
+// issued for so that a token cannot be replayed against another query.
+type pageToken struct {
+	Filter entity.UserFilter `json:"f"`
+	After  entity.UserCursor `json:"a"`
+}
+
+func encodePageToken(filter entity.UserFilter, last *entity.User) (string, error) {
+	raw, err := json.Marshal(pageToken{
+		Filter: filter,
+		After:  *entity.NewUserCursor(last),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePageToken(token string, filter entity.UserFilter) (*entity.UserCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed pageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
+
+// followPageToken is pageToken for follow listings.
+type followPageToken struct {
+	Filter entity.FollowFilter `json:"f"`
+	After  entity.FollowCursor `json:"a"`
+}
+
+func encodeFollowPageToken(filter entity.FollowFilter, last *entity.FollowedUser) (string, error) {
+	raw, err := json.Marshal(followPageToken{
+		Filter: filter,
+		After:  *entity.NewFollowCursor(last),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeFollowPageToken(token string, filter entity.FollowFilter) (*entity.FollowCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var parsed followPageToken
+	if err = json.Unmarshal(raw, &parsed); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if parsed.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &parsed.After, nil
+}
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
+)
+
+var (
+	ErrInvalidScope         = errors.New("invalid scope")
+	ErrInvalidExpiry        = errors.New("invalid token expiry")
+	ErrTooManyTokens        = errors.New("too many personal access tokens")
+	ErrInvalidPersonalToken = errors.New("invalid personal access token")
+)
+
+const (
+	maxTokenNameLen = 100
+
+	// last use is recorded at most this often per token
+	touchInterval = time.Minute
+)
+
+// newPersonalAccessKey signs the access token a personal token is
+// exchanged for. The "pat" claim restricts it to the scopes in "scp".
+func newPersonalAccessKey(user *entity.User, token *entity.PersonalToken, keys *authz.KeyRing, exp time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"uid":            user.ID.String(),
+		"ref":            token.ID.String(),
+		"role":           user.Role,
+		"email_verified": user.EmailVerified,
+		"pat":            true,
+		"scp":            token.Scopes,
+		"exp":            exp.Unix(),
+		"iat":            time.Now().Unix(),
+	}
+
+	return keys.Sign(claims)
+}
+
+// CreatePersonalToken issues a token for the user. A zero expiresAt means
+// the configured default lifetime.
+func (uc *UserCore) CreatePersonalToken(uid uuid.UUID, name string, scopes []string, expiresAt time.Time) (*entity.PersonalToken, string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || len(scopes) == 0 {
+		return nil, "", ErrEmptyFields
+	}
+
+	if len(name) > maxTokenNameLen {
+		return nil, "", ErrInvalidProfile
+	}
+
+	now := time.Now()
+
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(uc.cfg.PersonalTokens.DefaultTTL)
+	}
+
+	if !expiresAt.After(now) || expiresAt.After(now.Add(uc.cfg.PersonalTokens.MaxTTL)) {
+		return nil, "", ErrInvalidExpiry
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	user, err := uc.repo.GetUser(ctx, uid)
+	if err != nil {
+		return nil, "", err
+	}
+
+	granted := make([]string, 0, len(scopes))
+
+	for _, raw := range scopes {
+		scope, err := authz.ParseScope(raw)
+		if err != nil {
+			return nil, "", ErrInvalidScope
+		}
+
+		// the role is checked on use as well, this only keeps tokens honest
+		if scope == authz.ScopeUsersAdmin && !authz.Role(user.Role).Can(authz.PermManageUsers) {
+			return nil, "", ErrInvalidScope
+		}
+
+		if !slices.Contains(granted, raw) {
+			granted = append(granted, raw)
+		}
+	}
+
+	slices.Sort(granted)
+
+	active, err := uc.repo.ListPersonalTokens(ctx, uid)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(active) >= uc.cfg.PersonalTokens.MaxPerUser {
+		return nil, "", ErrTooManyTokens
+	}
+
+	token, raw, err := entity.NewPersonalToken(uid, name, granted, expiresAt)
+	if err != nil {
+		return nil, "", ErrInternal
+	}
+
+	if err = uc.repo.CreatePersonalToken(ctx, token); err != nil {
+		return nil, "", err
+	}
+
+	return token, raw, nil
+}
+
+func (uc *UserCore) ListPersonalTokens(uid uuid.UUID) ([]entity.PersonalToken, error) {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.ListPersonalTokens(ctx, uid)
+}
+
+func (uc *UserCore) RevokePersonalToken(uid, tokenID uuid.UUID) error {
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	return uc.repo.RevokePersonalToken(ctx, uid, tokenID)
+}
+
+// ExchangePersonalToken trades a personal token for an access token that
+// expires with the regular access token lifetime or with the personal
+// token, whichever comes first. The owner's role is read on every
+// exchange, so demotions apply to existing tokens.
+func (uc *UserCore) ExchangePersonalToken(raw string) (string, time.Time, error) {
+	if !strings.HasPrefix(raw, authz.PersonalTokenPrefix) {
+		return "", time.Time{}, ErrInvalidPersonalToken
+	}
+
+	ctx, cancel := uc.context()
+	defer cancel()
+
+	token, err := uc.repo.GetPersonalToken(ctx, entity.HashPersonalToken(raw))
+	if err != nil {
+		if errors.Is(err, repository.ErrPersonalTokenNotFound) {
+			return "", time.Time{}, ErrInvalidPersonalToken
+		}
+
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+
+	if !token.Active(now) {
+		return "", time.Time{}, ErrInvalidPersonalToken
+	}
+
+	user, err := uc.repo.GetUser(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", time.Time{}, ErrInvalidPersonalToken
+		}
+
+		return "", time.Time{}, err
+	}
+
+	exp := now.Add(uc.cfg.ATokenTTL)
+	if token.ExpiresAt.Before(exp) {
+		exp = token.ExpiresAt
+	}
+
+	access, err := newPersonalAccessKey(user, token, uc.keys, exp)
+	if err != nil {
+		return "", time.Time{}, ErrJwtFailed
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > touchInterval {
+		// a missed update only makes last_used_at stale
+		_ = uc.repo.TouchPersonalToken(ctx, token.ID, now)
+	}
+
+	return access, exp, nil
+}
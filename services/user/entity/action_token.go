@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenPurpose string
+
+const (
+	PurposeVerifyEmail   TokenPurpose = "verify_email"
+	PurposeResetPassword TokenPurpose = "reset_password"
+)
+
+// ActionToken is a single-use token mailed to the user. Only the SHA-256
+// of the token is stored, so a leaked table cannot be replayed.
+type ActionToken struct {
+	ID        uuid.UUID    `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID    uuid.UUID    `gorm:"type:uuid;index;not null" json:"user_id"`
+	Purpose   TokenPurpose `gorm:"size:20;not null" json:"purpose"`
+	Hash      string       `gorm:"size:64;uniqueIndex;not null" json:"-"`
+	Email     string       `gorm:"size:255" json:"email,omitempty"`
+	CreatedAt time.Time    `gorm:"autoCreateTime" json:"created_at"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    *time.Time   `json:"used_at,omitempty"`
+}
+
+// NewActionToken returns the stored token and the raw value to mail.
+func NewActionToken(userID uuid.UUID, purpose TokenPurpose, email string, ttl time.Duration) (*ActionToken, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	return &ActionToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		Hash:      HashActionToken(raw),
+		Email:     email,
+		ExpiresAt: time.Now().Add(ttl),
+	}, raw, nil
+}
+
+func HashActionToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+
+	return hex.EncodeToString(sum[:])
+}
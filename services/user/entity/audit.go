@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type AuditEvent string
+
+const (
+	AuditAccountLocked AuditEvent = "account_locked"
+	AuditIPLocked      AuditEvent = "ip_locked"
+)
+
+// AuditEntry records a security relevant event. Subject is the email or
+// address the event is about.
+type AuditEntry struct {
+	ID        uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	Event     AuditEvent `gorm:"size:50;index;not null" json:"event"`
+	Subject   string     `gorm:"size:255;index" json:"subject"`
+	IPAddress string     `gorm:"size:64" json:"ip_address"`
+	UserAgent string     `gorm:"size:255" json:"user_agent"`
+	Detail    string     `gorm:"size:500" json:"detail"`
+	CreatedAt time.Time  `gorm:"autoCreateTime;index" json:"created_at"`
+}
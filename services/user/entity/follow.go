@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Follow is an edge of the follow graph: FollowerID follows FolloweeID.
+type Follow struct {
+	FollowerID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FolloweeID uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func NewFollow(follower, followee uuid.UUID) *Follow {
+	return &Follow{
+		FollowerID: follower,
+		FolloweeID: followee,
+	}
+}
+
+type FollowDirection string
+
+const (
+	// FollowDirectionFollowers lists the users following UserID.
+	FollowDirectionFollowers FollowDirection = "followers"
+	// FollowDirectionFollowing lists the users UserID follows.
+	FollowDirectionFollowing FollowDirection = "following"
+)
+
+// FollowFilter selects one side of a user's follow graph.
+type FollowFilter struct {
+	UserID    uuid.UUID       `json:"u"`
+	Direction FollowDirection `json:"d"`
+}
+
+// FollowedUser is a user listed through a follow edge, newest edge first.
+type FollowedUser struct {
+	User       `gorm:"embedded"`
+	FollowedAt time.Time
+}
+
+// FollowCursor is the sort key of the last user on a page.
+type FollowCursor struct {
+	FollowedAt time.Time `json:"c"`
+	ID         uuid.UUID `json:"i"`
+}
+
+func NewFollowCursor(u *FollowedUser) *FollowCursor {
+	return &FollowCursor{
+		FollowedAt: u.FollowedAt,
+		ID:         u.ID,
+	}
+}
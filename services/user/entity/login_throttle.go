@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+const (
+	ThrottleAccountPrefix = "account:"
+	ThrottleIPPrefix      = "ip:"
+)
+
+// LoginThrottle counts recent failed logins for one key, either an
+// account email or a client address.
+type LoginThrottle struct {
+	Key           string     `gorm:"size:300;primaryKey" json:"key"`
+	Failures      int        `gorm:"not null" json:"failures"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+}
+
+func (t *LoginThrottle) Locked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
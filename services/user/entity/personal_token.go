@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// hintLen is how many trailing characters of a token are kept so that
+// users can tell their tokens apart.
+const hintLen = 4
+
+// PersonalToken lets scripts act for a user within Scopes without a
+// session. As with action tokens only the SHA-256 of the token is stored.
+type PersonalToken struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;index;not null" json:"user_id"`
+	Name       string     `gorm:"size:100;not null" json:"name"`
+	Scopes     []string   `gorm:"serializer:json" json:"scopes"`
+	Hash       string     `gorm:"size:64;uniqueIndex;not null" json:"-"`
+	Hint       string     `gorm:"size:16" json:"hint"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	ExpiresAt  time.Time  `gorm:"index" json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// NewPersonalToken returns the stored token and the raw value, which is
+// shown to the user once.
+func NewPersonalToken(userID uuid.UUID, name string, scopes []string, expiresAt time.Time) (*PersonalToken, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+
+	raw := authz.PersonalTokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	return &PersonalToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Scopes:    scopes,
+		Hash:      HashPersonalToken(raw),
+		Hint:      authz.PersonalTokenPrefix + "..." + raw[len(raw)-hintLen:],
+		ExpiresAt: expiresAt,
+	}, raw, nil
+}
+
+func HashPersonalToken(raw string) string {
+	return HashActionToken(raw)
+}
+
+// Active reports whether the token may still be used at now.
+func (t *PersonalToken) Active(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+func (t *PersonalToken) ToProto() *pb.PersonalToken {
+	token := &pb.PersonalToken{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		Hint:      t.Hint,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		ExpiresAt: timestamppb.New(t.ExpiresAt),
+	}
+
+	if t.LastUsedAt != nil {
+		token.LastUsedAt = timestamppb.New(*t.LastUsedAt)
+	}
+
+	return token
+}
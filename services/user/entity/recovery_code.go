@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	RecoveryCodeCount = 10
+
+	recoveryCodeHalf = 5
+)
+
+// RecoveryCode stands in for a TOTP code once. The code is hashed with
+// bcrypt like a password.
+type RecoveryCode struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;index;not null" json:"user_id"`
+	Hash      string     `gorm:"size:255;not null" json:"-"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+// NewRecoveryCodes returns the stored codes and their plain values, which
+// are shown to the user once.
+func NewRecoveryCodes(userID uuid.UUID) ([]RecoveryCode, []string, error) {
+	codes := make([]RecoveryCode, RecoveryCodeCount)
+	plain := make([]string, RecoveryCodeCount)
+
+	for i := range codes {
+		raw := randomRecoveryCode()
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(NormalizeRecoveryCode(raw)), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes[i] = RecoveryCode{
+			ID:     uuid.New(),
+			UserID: userID,
+			Hash:   string(hash),
+		}
+		plain[i] = raw
+	}
+
+	return codes, plain, nil
+}
+
+// Matches compares the code the way NewRecoveryCodes hashed it.
+func (c *RecoveryCode) Matches(raw string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(c.Hash), []byte(NormalizeRecoveryCode(raw))) == nil
+}
+
+// NormalizeRecoveryCode accepts codes typed in upper case, with spaces, or
+// with or without the dash in the middle, whichever dash was typed.
+func NormalizeRecoveryCode(raw string) string {
+	return strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.Is(unicode.Pd, r) || r == '\u2212' {
+			return -1
+		}
+
+		return r
+	}, raw))
+}
+
+// randomRecoveryCode returns ten base32 characters, about 50 bits, as
+// "xxxxx-xxxxx".
+func randomRecoveryCode() string {
+	text := strings.ToLower(rand.Text())
+
+	return text[:recoveryCodeHalf] + "-" + text[recoveryCodeHalf:2*recoveryCodeHalf]
+}
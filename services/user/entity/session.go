@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// ClientInfo describes the device a session was opened from.
+	ClientInfo struct {
+		UserAgent string
+		IPAddress string
+	}
+
+	// Session groups every refresh token rotated from a single login.
+	// Presenting an already rotated token revokes the whole session.
+	Session struct {
+		ID         uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+		UserID     uuid.UUID  `gorm:"type:uuid;index;not null" json:"user_id"`
+		UserAgent  string     `gorm:"size:255" json:"user_agent"`
+		IPAddress  string     `gorm:"size:64" json:"ip_address"`
+		CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+		LastUsedAt time.Time  `json:"last_used_at"`
+		ExpiresAt  time.Time  `gorm:"index" json:"expires_at"`
+		RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	}
+
+	// RefreshToken is keyed by the jti claim of the issued token.
+	RefreshToken struct {
+		ID        uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+		SessionID uuid.UUID  `gorm:"type:uuid;index;not null" json:"session_id"`
+		CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+		ExpiresAt time.Time  `json:"expires_at"`
+		UsedAt    *time.Time `json:"used_at,omitempty"`
+	}
+)
+
+func NewSession(userID uuid.UUID, client ClientInfo, ttl time.Duration) *Session {
+	now := time.Now()
+
+	return &Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		UserAgent:  client.UserAgent,
+		IPAddress:  client.IPAddress,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+}
+
+func NewRefreshToken(sessionID uuid.UUID, ttl time.Duration) *RefreshToken {
+	return &RefreshToken{
+		ID:        uuid.New(),
+		SessionID: sessionID,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+}
+
+func (s *Session) ToProto() *pb.Session {
+	return &pb.Session{
+		Id:         s.ID.String(),
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+		LastUsedAt: timestamppb.New(s.LastUsedAt),
+		ExpiresAt:  timestamppb.New(s.ExpiresAt),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -12,7 +13,7 @@ import (
 
 type (
 	User struct {
-		ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+		ID        uuid.UUID      `gorm:"type:uuid;primaryKey" json:"id"`
 		CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at"`
 		UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
 		DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
@@ -22,6 +23,21 @@ type (
 		Email    string `gorm:"size:255;uniqueIndex" json:"email,omitempty" validate:"omitempty,email"`
 		Likes    int    `json:"likes"`
 		Reviews  int    `json:"reciews"`
+		Role     string `gorm:"size:20;not null;default:user" json:"role"`
+
+		Followers int `gorm:"not null;default:0" json:"followers"`
+		Following int `gorm:"not null;default:0" json:"following"`
+
+		EmailVerified bool `gorm:"not null;default:false" json:"email_verified"`
+
+		// TOTPSecret is set on enrollment, TOTPEnabled once a code confirmed it.
+		TOTPSecret   string `gorm:"size:64" json:"-"`
+		TOTPEnabled  bool   `gorm:"not null;default:false" json:"totp_enabled"`
+		TOTPLastStep int64  `json:"-"`
+
+		DisplayName string `gorm:"size:100" json:"display_name,omitempty"`
+		Bio         string `gorm:"size:500" json:"bio,omitempty"`
+		AvatarURL   string `gorm:"size:255" json:"avatar_url,omitempty"`
 	}
 )
 
@@ -30,6 +46,7 @@ func NewUser(username, password, email string) *User {
 		Username: username,
 		Password: password,
 		Email:    email,
+		Role:     string(authz.RoleUser),
 	}
 }
 
@@ -57,6 +74,16 @@ func (u *User) ToProto() *pb.User {
 		UpdatedAt: timestamppb.New(u.UpdatedAt),
 		Likes:     int64(u.Likes),
 		Reviews:   int64(u.Reviews),
+		Followers: int64(u.Followers),
+		Following: int64(u.Following),
 		Id:        u.ID.String(),
+		Role:      u.Role,
+
+		EmailVerified: u.EmailVerified,
+		TotpEnabled:   u.TOTPEnabled,
+
+		DisplayName: u.DisplayName,
+		Bio:         u.Bio,
+		AvatarUrl:   u.AvatarURL,
 	}
 }
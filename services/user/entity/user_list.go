@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserOrder string
+
+const (
+	UserOrderCreatedAt UserOrder = "created_at"
+	UserOrderReviews   UserOrder = "reviews"
+	UserOrderLikes     UserOrder = "likes"
+)
+
+// UserFilter selects and orders users for ListUsers.
+type UserFilter struct {
+	UsernamePrefix string    `json:"p,omitempty"`
+	OrderBy        UserOrder `json:"o"`
+	Desc           bool      `json:"d,omitempty"`
+}
+
+// UserCursor is the sort key of the last user on a page.
+type UserCursor struct {
+	CreatedAt time.Time `json:"c"`
+	Reviews   int       `json:"r"`
+	Likes     int       `json:"l"`
+	ID        uuid.UUID `json:"i"`
+}
+
+func NewUserCursor(u *User) *UserCursor {
+	return &UserCursor{
+		CreatedAt: u.CreatedAt,
+		Reviews:   u.Reviews,
+		Likes:     u.Likes,
+		ID:        u.ID,
+	}
+}
@@ -0,0 +1,57 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/user/config"
+	"go.uber.org/zap"
+)
+
+// FileMailer is meant for local development and tests: every mail is
+// logged and, when a directory is configured, written there as an .eml file.
+type FileMailer struct {
+	dir    string
+	from   string
+	logger *logger.Logger
+}
+
+func NewFileMailer(cfg *config.MailerConfig, logger *logger.Logger) *FileMailer {
+	return &FileMailer{
+		dir:    cfg.Dir,
+		from:   cfg.From,
+		logger: logger,
+	}
+}
+
+func (m *FileMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.logger.Info("mail",
+		zap.String("to", to),
+		zap.String("subject", subject),
+		zap.String("body", body))
+
+	if m.dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("failed create mail dir: %w", err)
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.NewReplacer("@", "_at_", "/", "_").Replace(to))
+
+	if err := os.WriteFile(filepath.Join(m.dir, name), message(m.from, to, subject, body), 0o644); err != nil {
+		m.logger.Error("failed to write mail",
+			zap.String("dir", m.dir),
+			zap.Error(err))
+
+		return fmt.Errorf("failed write mail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/logger"
+	"github.com/osamikoyo/music-and-marks/services/user/config"
+	"go.uber.org/zap"
+)
+
+type SMTPMailer struct {
+	addr   string
+	from   string
+	auth   smtp.Auth
+	logger *logger.Logger
+}
+
+func NewSMTPMailer(cfg *config.MailerConfig, logger *logger.Logger) *SMTPMailer {
+	var auth smtp.Auth
+	if cfg.SMTP.Username != "" {
+		auth = smtp.PlainAuth("", cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.Host)
+	}
+
+	return &SMTPMailer{
+		addr:   net.JoinHostPort(cfg.SMTP.Host, strconv.Itoa(cfg.SMTP.Port)),
+		from:   cfg.From,
+		auth:   auth,
+		logger: logger,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.logger.Info("sending mail...",
+		zap.String("to", to),
+		zap.String("subject", subject))
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{to}, message(m.from, to, subject, body)); err != nil {
+		m.logger.Error("failed to send mail",
+			zap.String("to", to),
+			zap.String("addr", m.addr),
+			zap.Error(err))
+
+		return fmt.Errorf("failed send mail: %w", err)
+	}
+
+	return nil
+}
+
+func message(from, to, subject, body string) []byte {
+	var b strings.Builder
+
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var ErrActionTokenNotFound = errors.New("token is invalid or expired")
+
+// CreateActionToken stores the token and invalidates the unused tokens the
+// user was mailed earlier for the same purpose.
+func (r *Repository) CreateActionToken(ctx context.Context, token *entity.ActionToken) error {
+	r.logger.Info("creating action token...",
+		zap.String("user_id", token.UserID.String()),
+		zap.String("purpose", string(token.Purpose)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.ActionToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", token.UserID, token.Purpose).
+			Update("used_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(token).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to create action token",
+			zap.String("user_id", token.UserID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// VerifyEmail consumes the token and marks the address it was sent to as
+// verified. Tokens sent to a previous address of the user are rejected.
+func (r *Repository) VerifyEmail(ctx context.Context, hash string) (*entity.User, error) {
+	r.logger.Info("verifying email...")
+
+	var user entity.User
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		token, err := consumeActionToken(tx, hash, entity.PurposeVerifyEmail)
+		if err != nil {
+			return err
+		}
+
+		if err = tx.First(&user, "id = ?", token.UserID).Error; err != nil {
+			return err
+		}
+
+		if user.Email != token.Email {
+			return ErrActionTokenNotFound
+		}
+
+		user.EmailVerified = true
+
+		return tx.Model(&user).Update("email_verified", true).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to verify email",
+			zap.Error(err))
+
+		if errors.Is(err, ErrActionTokenNotFound) || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrActionTokenNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	r.logger.Info("email was verified successfully",
+		zap.String("user_id", user.ID.String()))
+
+	return &user, nil
+}
+
+// ResetPassword consumes the token, stores the new password hash and
+// revokes every session of the user.
+func (r *Repository) ResetPassword(ctx context.Context, hash, password string) error {
+	r.logger.Info("resetting password...")
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		token, err := consumeActionToken(tx, hash, entity.PurposeResetPassword)
+		if err != nil {
+			return err
+		}
+
+		res := tx.Model(&entity.User{}).
+			Where("id = ?", token.UserID).
+			Update("password", password)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return ErrActionTokenNotFound
+		}
+
+		return tx.Model(&entity.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL", token.UserID).
+			Update("revoked_at", time.Now()).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to reset password",
+			zap.Error(err))
+
+		if errors.Is(err, ErrActionTokenNotFound) {
+			return ErrActionTokenNotFound
+		}
+
+		return ErrInternal
+	}
+
+	r.logger.Info("password was reset successfully")
+
+	return nil
+}
+
+func consumeActionToken(tx *gorm.DB, hash string, purpose entity.TokenPurpose) (*entity.ActionToken, error) {
+	var token entity.ActionToken
+
+	err := tx.First(&token, "hash = ? AND purpose = ?", hash, purpose).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrActionTokenNotFound
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+	if now.After(token.ExpiresAt) {
+		return nil, ErrActionTokenNotFound
+	}
+
+	res := tx.Model(&entity.ActionToken{}).
+		Where("id = ? AND used_at IS NULL", token.ID).
+		Update("used_at", now)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, ErrActionTokenNotFound
+	}
+
+	return &token, nil
+}
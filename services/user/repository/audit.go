@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+)
+
+func (r *Repository) CreateAuditEntry(ctx context.Context, entry *entity.AuditEntry) error {
+	r.logger.Warn("audit",
+		zap.String("event", string(entry.Event)),
+		zap.String("subject", entry.Subject),
+		zap.String("ip_address", entry.IPAddress),
+		zap.String("detail", entry.Detail))
+
+	if err := r.db.WithContext(ctx).Create(entry).Error; err != nil {
+		r.logger.Error("failed to create audit entry",
+			zap.String("event", string(entry.Event)),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
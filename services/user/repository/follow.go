@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAlreadyFollowing = errors.New("already following")
+	ErrNotFollowing     = errors.New("not following")
+)
+
+// CreateFollow stores the edge and bumps the counters of both users.
+func (r *Repository) CreateFollow(ctx context.Context, follow *entity.Follow) error {
+	r.logger.Info("creating follow...",
+		zap.String("follower_id", follow.FollowerID.String()),
+		zap.String("followee_id", follow.FolloweeID.String()))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+
+		err := tx.Model(&entity.Follow{}).
+			Where("follower_id = ? AND followee_id = ?", follow.FollowerID, follow.FolloweeID).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return ErrAlreadyFollowing
+		}
+
+		if err = tx.Create(follow).Error; err != nil {
+			return err
+		}
+
+		return updateFollowCounts(tx, follow, 1)
+	})
+	if err != nil {
+		if errors.Is(err, ErrAlreadyFollowing) || errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrAlreadyFollowing
+		}
+
+		r.logger.Error("failed to create follow",
+			zap.String("follower_id", follow.FollowerID.String()),
+			zap.String("followee_id", follow.FolloweeID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteFollow(ctx context.Context, follower, followee uuid.UUID) error {
+	r.logger.Info("deleting follow...",
+		zap.String("follower_id", follower.String()),
+		zap.String("followee_id", followee.String()))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		follow := entity.NewFollow(follower, followee)
+
+		res := tx.Where("follower_id = ? AND followee_id = ?", follower, followee).Delete(&entity.Follow{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return ErrNotFollowing
+		}
+
+		return updateFollowCounts(tx, follow, -1)
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotFollowing) {
+			return ErrNotFollowing
+		}
+
+		r.logger.Error("failed to delete follow",
+			zap.String("follower_id", follower.String()),
+			zap.String("followee_id", followee.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func updateFollowCounts(tx *gorm.DB, follow *entity.Follow, delta int) error {
+	err := tx.Model(&entity.User{}).
+		Where("id = ?", follow.FollowerID).
+		UpdateColumn("following", gorm.Expr("following + ?", delta)).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Model(&entity.User{}).
+		Where("id = ?", follow.FolloweeID).
+		UpdateColumn("followers", gorm.Expr("followers + ?", delta)).Error
+}
+
+// deleteFollows drops every edge of the user and the counters they held on
+// the other side.
+func deleteFollows(tx *gorm.DB, id uuid.UUID) error {
+	err := tx.Model(&entity.User{}).
+		Where("id IN (?)", tx.Model(&entity.Follow{}).Select("followee_id").Where("follower_id = ?", id)).
+		UpdateColumn("followers", gorm.Expr("followers - 1")).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Model(&entity.User{}).
+		Where("id IN (?)", tx.Model(&entity.Follow{}).Select("follower_id").Where("followee_id = ?", id)).
+		UpdateColumn("following", gorm.Expr("following - 1")).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Where("follower_id = ? OR followee_id = ?", id, id).Delete(&entity.Follow{}).Error
+}
+
+// ListFollows returns up to limit users on one side of the follow graph,
+// most recent follow first, starting right after the cursor when one is
+// given.
+func (r *Repository) ListFollows(ctx context.Context, filter entity.FollowFilter, after *entity.FollowCursor, limit int) ([]entity.FollowedUser, error) {
+	r.logger.Info("listing follows...",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	// the edge is matched on one column and joins the user on the other
+	match, join := "followee_id", "follower_id"
+	if filter.Direction == entity.FollowDirectionFollowing {
+		match, join = join, match
+	}
+
+	query := r.db.WithContext(ctx).
+		Table("follows").
+		Select("users.*, follows.created_at AS followed_at").
+		Joins("JOIN users ON users.id = follows."+join+" AND users.deleted_at IS NULL").
+		Where("follows."+match+" = ?", filter.UserID)
+
+	if after != nil {
+		query = query.Where(
+			"follows.created_at < ? OR (follows.created_at = ? AND users.id < ?)",
+			after.FollowedAt, after.FollowedAt, after.ID,
+		)
+	}
+
+	var users []entity.FollowedUser
+
+	err := query.
+		Order("follows.created_at DESC, users.id DESC").
+		Limit(limit).
+		Scan(&users).Error
+	if err != nil {
+		r.logger.Error("failed to list follows",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return users, nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrIdentityNotFound  = errors.New("identity not found")
+	ErrOIDCStateNotFound = errors.New("oidc state not found")
+)
+
+// CreateOIDCState stores the state and drops expired ones.
+func (r *Repository) CreateOIDCState(ctx context.Context, state *entity.OIDCState) error {
+	r.logger.Info("creating oidc state...",
+		zap.String("provider", state.Provider))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at < ?", time.Now()).Delete(&entity.OIDCState{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(state).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to create oidc state",
+			zap.String("provider", state.Provider),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ConsumeOIDCState deletes the state and returns it, so that every state
+// completes at most one flow.
+func (r *Repository) ConsumeOIDCState(ctx context.Context, hash string) (*entity.OIDCState, error) {
+	r.logger.Info("consuming oidc state...")
+
+	var state entity.OIDCState
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&state, "hash = ?", hash).Error; err != nil {
+			return err
+		}
+
+		res := tx.Delete(&entity.OIDCState{}, "id = ?", state.ID)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOIDCStateNotFound
+		}
+
+		r.logger.Error("failed to consume oidc state",
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	if time.Now().After(state.ExpiresAt) {
+		return nil, ErrOIDCStateNotFound
+	}
+
+	return &state, nil
+}
+
+func (r *Repository) CreateIdentity(ctx context.Context, identity *entity.Identity) error {
+	r.logger.Info("creating identity...",
+		zap.String("user_id", identity.UserID.String()),
+		zap.String("provider", identity.Provider))
+
+	if err := r.db.WithContext(ctx).Create(identity).Error; err != nil {
+		r.logger.Error("failed to create identity",
+			zap.String("user_id", identity.UserID.String()),
+			zap.String("provider", identity.Provider),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	r.logger.Info("identity was created successfully",
+		zap.String("identity_id", identity.ID.String()))
+
+	return nil
+}
+
+func (r *Repository) GetIdentity(ctx context.Context, provider, subject string) (*entity.Identity, error) {
+	r.logger.Info("fetching identity...",
+		zap.String("provider", provider))
+
+	var identity entity.Identity
+
+	err := r.db.WithContext(ctx).
+		First(&identity, "provider = ? AND subject = ?", provider, subject).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrIdentityNotFound
+		}
+
+		r.logger.Error("failed to fetch identity",
+			zap.String("provider", provider),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &identity, nil
+}
+
+func (r *Repository) ListIdentities(ctx context.Context, userID uuid.UUID) ([]entity.Identity, error) {
+	r.logger.Info("listing identities...",
+		zap.String("user_id", userID.String()))
+
+	var identities []entity.Identity
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at").
+		Find(&identities).Error
+	if err != nil {
+		r.logger.Error("failed to list identities",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return identities, nil
+}
+
+func (r *Repository) TouchIdentity(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entity.Identity{}).
+		Where("id = ?", id).
+		Update("last_login_at", at).Error
+	if err != nil {
+		r.logger.Error("failed to touch identity",
+			zap.String("identity_id", id.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteIdentity(ctx context.Context, userID uuid.UUID, provider string) error {
+	r.logger.Info("deleting identity...",
+		zap.String("user_id", userID.String()),
+		zap.String("provider", provider))
+
+	res := r.db.WithContext(ctx).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Delete(&entity.Identity{})
+	if err := res.Error; err != nil {
+		r.logger.Error("failed to delete identity",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrIdentityNotFound
+	}
+
+	r.logger.Info("identity was deleted successfully",
+		zap.String("user_id", userID.String()),
+		zap.String("provider", provider))
+
+	return nil
+}
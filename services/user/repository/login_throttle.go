@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) GetLoginThrottles(ctx context.Context, keys ...string) ([]entity.LoginThrottle, error) {
+	var throttles []entity.LoginThrottle
+
+	if err := r.db.WithContext(ctx).Where("key IN ?", keys).Find(&throttles).Error; err != nil {
+		r.logger.Error("failed to fetch login throttles",
+			zap.Strings("keys", keys),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return throttles, nil
+}
+
+// RecordLoginFailure loads the throttle of the key, or a zero one, lets
+// apply update it and stores the result in one transaction.
+func (r *Repository) RecordLoginFailure(ctx context.Context, key string, apply func(t *entity.LoginThrottle)) (*entity.LoginThrottle, error) {
+	r.logger.Info("recording login failure...",
+		zap.String("key", key))
+
+	var throttle entity.LoginThrottle
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.First(&throttle, "key = ?", key).Error
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			throttle = entity.LoginThrottle{Key: key}
+		}
+
+		apply(&throttle)
+
+		return tx.Save(&throttle).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to record login failure",
+			zap.String("key", key),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &throttle, nil
+}
+
+func (r *Repository) ResetLoginThrottle(ctx context.Context, key string) error {
+	if err := r.db.WithContext(ctx).Delete(&entity.LoginThrottle{}, "key = ?", key).Error; err != nil {
+		r.logger.Error("failed to reset login throttle",
+			zap.String("key", key),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// UseTOTPStep records the time step of an accepted code. It reports false
+// when the step, or a later one, was already used.
+func (r *Repository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&entity.User{}).
+		Where("id = ? AND totp_last_step < ?", userID, step).
+		Update("totp_last_step", step)
+	if err := res.Error; err != nil {
+		r.logger.Error("failed to use totp step",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return false, ErrInternal
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+// ReplaceRecoveryCodes drops every recovery code of the user and stores
+// codes instead.
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codes []entity.RecoveryCode) error {
+	r.logger.Info("replacing recovery codes...",
+		zap.String("user_id", userID.String()),
+		zap.Int("count", len(codes)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entity.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		if len(codes) == 0 {
+			return nil
+		}
+
+		return tx.Create(&codes).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to replace recovery codes",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *Repository) ListRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]entity.RecoveryCode, error) {
+	var codes []entity.RecoveryCode
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Find(&codes).Error
+	if err != nil {
+		r.logger.Error("failed to list recovery codes",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return codes, nil
+}
+
+// UseRecoveryCode marks the code as used. It reports false when it already was.
+func (r *Repository) UseRecoveryCode(ctx context.Context, id uuid.UUID) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&entity.RecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if err := res.Error; err != nil {
+		r.logger.Error("failed to use recovery code",
+			zap.String("id", id.String()),
+			zap.Error(err))
+
+		return false, ErrInternal
+	}
+
+	return res.RowsAffected == 1, nil
+}
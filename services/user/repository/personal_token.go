@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var ErrPersonalTokenNotFound = errors.New("personal access token not found")
+
+func (r *Repository) CreatePersonalToken(ctx context.Context, token *entity.PersonalToken) error {
+	r.logger.Info("creating personal token...",
+		zap.String("token_id", token.ID.String()),
+		zap.String("user_id", token.UserID.String()))
+
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		r.logger.Error("failed to create personal token",
+			zap.String("token_id", token.ID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	r.logger.Info("personal token was created successfully",
+		zap.String("token_id", token.ID.String()))
+
+	return nil
+}
+
+func (r *Repository) GetPersonalToken(ctx context.Context, hash string) (*entity.PersonalToken, error) {
+	r.logger.Info("fetching personal token...")
+
+	var token entity.PersonalToken
+
+	if err := r.db.WithContext(ctx).First(&token, "hash = ?", hash).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPersonalTokenNotFound
+		}
+
+		r.logger.Error("failed to fetch personal token",
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return &token, nil
+}
+
+// ListPersonalTokens returns the tokens of the user that are neither
+// revoked nor expired, newest first.
+func (r *Repository) ListPersonalTokens(ctx context.Context, userID uuid.UUID) ([]entity.PersonalToken, error) {
+	r.logger.Info("listing personal tokens...",
+		zap.String("user_id", userID.String()))
+
+	var tokens []entity.PersonalToken
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		r.logger.Error("failed to list personal tokens",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return tokens, nil
+}
+
+func (r *Repository) RevokePersonalToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	r.logger.Info("revoking personal token...",
+		zap.String("user_id", userID.String()),
+		zap.String("token_id", tokenID.String()))
+
+	res := r.db.WithContext(ctx).Model(&entity.PersonalToken{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", tokenID, userID).
+		Update("revoked_at", time.Now())
+	if err := res.Error; err != nil {
+		r.logger.Error("failed to revoke personal token",
+			zap.String("token_id", tokenID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrPersonalTokenNotFound
+	}
+
+	r.logger.Info("personal token was revoked successfully",
+		zap.String("token_id", tokenID.String()))
+
+	return nil
+}
+
+func (r *Repository) TouchPersonalToken(ctx context.Context, tokenID uuid.UUID, at time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entity.PersonalToken{}).
+		Where("id = ?", tokenID).
+		Update("last_used_at", at).Error
+	if err != nil {
+		r.logger.Error("failed to touch personal token",
+			zap.String("token_id", tokenID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/osamikoyo/music-and-marks/logger"
@@ -59,12 +61,16 @@ func (r *Repository) CreateUser(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
+// counterColumns are changed by atomic increments only; saving a user
+// writes every other column and would undo concurrent increments.
+var counterColumns = []string{"likes", "reviews", "followers", "following"}
+
 func (r *Repository) UpdateUser(ctx context.Context, update *entity.User) error {
 	r.logger.Info("updating user...",
 		zap.Any("update", update))
 
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.WithContext(ctx).Save(update).Error; err != nil {
+		if err := tx.WithContext(ctx).Omit(counterColumns...).Save(update).Error; err != nil {
 			return err
 		}
 
@@ -84,6 +90,42 @@ func (r *Repository) UpdateUser(ctx context.Context, update *entity.User) error
 	return nil
 }
 
+// AddLikes adds delta to the like counter of the user.
+func (r *Repository) AddLikes(ctx context.Context, id uuid.UUID, delta int) error {
+	return r.addCounter(ctx, id, "likes", delta)
+}
+
+// AddReviews adds delta to the review counter of the user.
+func (r *Repository) AddReviews(ctx context.Context, id uuid.UUID, delta int) error {
+	return r.addCounter(ctx, id, "reviews", delta)
+}
+
+func (r *Repository) addCounter(ctx context.Context, id uuid.UUID, column string, delta int) error {
+	r.logger.Info("updating user counter...",
+		zap.String("id", id.String()),
+		zap.String("counter", column),
+		zap.Int("delta", delta))
+
+	res := r.db.WithContext(ctx).
+		Model(&entity.User{}).
+		Where("id = ?", id).
+		UpdateColumn(column, gorm.Expr(column+" + ?", delta))
+	if res.Error != nil {
+		r.logger.Error("failed update user counter",
+			zap.String("id", id.String()),
+			zap.String("counter", column),
+			zap.Error(res.Error))
+
+		return ErrInternal
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *Repository) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	r.logger.Info("deleting user...",
 		zap.String("id", id.String()))
@@ -93,7 +135,12 @@ func (r *Repository) DeleteUser(ctx context.Context, id uuid.UUID) error {
 			return err
 		}
 
-		return nil
+		if err := deleteFollows(tx.WithContext(ctx), id); err != nil {
+			return err
+		}
+
+		// external accounts may sign up again once their user is gone
+		return tx.WithContext(ctx).Where("user_id = ?", id).Delete(&entity.Identity{}).Error
 	})
 
 	if err != nil {
@@ -120,7 +167,7 @@ func (r *Repository) GetUser(ctx context.Context, id uuid.UUID) (*entity.User, e
 
 	var user entity.User
 
-	err := r.db.WithContext(ctx).First(&user).Error
+	err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error
 
 	if err != nil {
 		r.logger.Error("failed to fetch user",
@@ -140,7 +187,7 @@ func (r *Repository) GetUser(ctx context.Context, id uuid.UUID) (*entity.User, e
 	return &user, nil
 }
 
-func (r *Repository) CheckUser(ctx context.Context, email, password string) (string, error) {
+func (r *Repository) CheckUser(ctx context.Context, email, password string) (*entity.User, error) {
 	r.logger.Info("checking user...",
 		zap.String("email", email))
 
@@ -149,26 +196,65 @@ func (r *Repository) CheckUser(ctx context.Context, email, password string) (str
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.logger.Warn("user not found",
 				zap.String("email", email))
-			return "", ErrNotFound
+			return nil, ErrNotFound
 		}
 
 		r.logger.Error("failed to query user",
 			zap.String("email", email),
 			zap.Error(err))
-		return "", ErrInternal
+		return nil, ErrInternal
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
 		r.logger.Warn("invalid password",
 			zap.String("email", email))
-		return "", ErrNotFound
+		return nil, ErrNotFound
 	}
 
 	r.logger.Info("user authenticated successfully",
 		zap.String("user_id", user.ID.String()),
 		zap.String("email", email))
 
-	return user.ID.String(), nil
+	return &user, nil
+}
+
+func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.logger.Info("fetching user by email...",
+		zap.String("email", email))
+
+	var user entity.User
+
+	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	if err != nil {
+		r.logger.Error("failed to fetch user by email",
+			zap.String("email", email),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &user, nil
+}
+
+func (r *Repository) CountUsersByRole(ctx context.Context, role string) (int64, error) {
+	r.logger.Info("counting users by role...",
+		zap.String("role", role))
+
+	var count int64
+
+	if err := r.db.WithContext(ctx).Model(&entity.User{}).Where("role = ?", role).Count(&count).Error; err != nil {
+		r.logger.Error("failed to count users by role",
+			zap.String("role", role),
+			zap.Error(err))
+
+		return 0, ErrInternal
+	}
+
+	return count, nil
 }
 
 func (r *Repository) GetUserByUsername(ctx context.Context, username string) (*entity.User, error) {
@@ -196,3 +282,80 @@ func (r *Repository) GetUserByUsername(ctx context.Context, username string) (*e
 
 	return &user, nil
 }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *Repository) filterUsers(ctx context.Context, filter entity.UserFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&entity.User{})
+
+	if filter.UsernamePrefix != "" {
+		query = query.Where(`username LIKE ? ESCAPE '\'`, likeEscaper.Replace(filter.UsernamePrefix)+"%")
+	}
+
+	return query
+}
+
+// ListUsers returns up to limit users ordered by the filter, starting right
+// after the cursor when one is given.
+func (r *Repository) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.UserCursor, limit int) ([]entity.User, error) {
+	r.logger.Info("listing users...",
+		zap.Any("filter", filter),
+		zap.Int("limit", limit))
+
+	column := string(filter.OrderBy)
+
+	dir, cmp := "ASC", ">"
+	if filter.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	query := r.filterUsers(ctx, filter)
+
+	if after != nil {
+		var value any
+
+		switch filter.OrderBy {
+		case entity.UserOrderReviews:
+			value = after.Reviews
+		case entity.UserOrderLikes:
+			value = after.Likes
+		default:
+			value = after.CreatedAt
+		}
+
+		query = query.Where(
+			fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", column, cmp),
+			value, value, after.ID,
+		)
+	}
+
+	var users []entity.User
+
+	err := query.
+		Order(fmt.Sprintf("%s %s, id %s", column, dir, dir)).
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		r.logger.Error("failed to list users",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return users, nil
+}
+
+func (r *Repository) CountUsers(ctx context.Context, filter entity.UserFilter) (int64, error) {
+	var count int64
+
+	if err := r.filterUsers(ctx, filter).Count(&count).Error; err != nil {
+		r.logger.Error("failed to count users",
+			zap.Any("filter", filter),
+			zap.Error(err))
+
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+func (r *Repository) CreateSession(ctx context.Context, session *entity.Session, token *entity.RefreshToken) error {
+	r.logger.Info("creating session...",
+		zap.String("session_id", session.ID.String()),
+		zap.String("user_id", session.UserID.String()))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(token).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to create session",
+			zap.String("session_id", session.ID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	r.logger.Info("session was created successfully",
+		zap.String("session_id", session.ID.String()))
+
+	return nil
+}
+
+func (r *Repository) GetSession(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	r.logger.Info("fetching session...",
+		zap.String("id", id.String()))
+
+	var session entity.Session
+
+	if err := r.db.WithContext(ctx).First(&session, "id = ?", id).Error; err != nil {
+		r.logger.Error("failed to fetch session",
+			zap.String("id", id.String()),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &session, nil
+}
+
+func (r *Repository) GetRefreshToken(ctx context.Context, id uuid.UUID) (*entity.RefreshToken, error) {
+	r.logger.Info("fetching refresh token...",
+		zap.String("jti", id.String()))
+
+	var token entity.RefreshToken
+
+	if err := r.db.WithContext(ctx).First(&token, "id = ?", id).Error; err != nil {
+		r.logger.Error("failed to fetch refresh token",
+			zap.String("jti", id.String()),
+			zap.Error(err))
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, ErrInternal
+	}
+
+	return &token, nil
+}
+
+// RotateRefreshToken consumes the used token and stores its successor in one
+// transaction. It reports false when the token had already been consumed.
+func (r *Repository) RotateRefreshToken(ctx context.Context, used uuid.UUID, next *entity.RefreshToken) (bool, error) {
+	r.logger.Info("rotating refresh token...",
+		zap.String("jti", used.String()),
+		zap.String("session_id", next.SessionID.String()))
+
+	rotated := true
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		res := tx.Model(&entity.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL", used).
+			Update("used_at", now)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			rotated = false
+
+			return nil
+		}
+
+		if err := tx.Create(next).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.Session{}).
+			Where("id = ?", next.SessionID).
+			Updates(map[string]any{
+				"last_used_at": now,
+				"expires_at":   next.ExpiresAt,
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error("failed to rotate refresh token",
+			zap.String("jti", used.String()),
+			zap.Error(err))
+
+		return false, ErrInternal
+	}
+
+	return rotated, nil
+}
+
+func (r *Repository) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	r.logger.Info("revoking session...",
+		zap.String("user_id", userID.String()),
+		zap.String("session_id", sessionID.String()))
+
+	res := r.db.WithContext(ctx).Model(&entity.Session{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", time.Now())
+	if err := res.Error; err != nil {
+		r.logger.Error("failed to revoke session",
+			zap.String("session_id", sessionID.String()),
+			zap.Error(err))
+
+		return ErrInternal
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+
+	r.logger.Info("session was revoked successfully",
+		zap.String("session_id", sessionID.String()))
+
+	return nil
+}
+
+func (r *Repository) ListSessions(ctx context.Context, userID uuid.UUID) ([]entity.Session, error) {
+	r.logger.Info("listing sessions...",
+		zap.String("user_id", userID.String()))
+
+	var sessions []entity.Session
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		r.logger.Error("failed to list sessions",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/metrics"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func followStatus(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, repository.ErrNotFollowing):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrEmptyFields), errors.Is(err, core.ErrFollowSelf),
+		errors.Is(err, core.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrAlreadyFollowing):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return err
+}
+
+func (uss *UserServiceServer) parseFollow(followerID, followeeID string) (uuid.UUID, uuid.UUID, error) {
+	follower, err := uuid.Parse(followerID)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", followerID))
+
+		return uuid.Nil, uuid.Nil, ErrInvalidUUID
+	}
+
+	followee, err := uuid.Parse(followeeID)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", followeeID))
+
+		return uuid.Nil, uuid.Nil, ErrInvalidUUID
+	}
+
+	return follower, followee, nil
+}
+
+func (uss *UserServiceServer) Follow(ctx context.Context, req *pb.FollowRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("Follow").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new follow request",
+		zap.String("follower_id", req.FollowerId),
+		zap.String("followee_id", req.FolloweeId))
+
+	follower, followee, err := uss.parseFollow(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = authorizeSelf(ctx, req.FollowerId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.Follow(follower, followee); err != nil {
+		return &emptypb.Empty{}, followStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("Follow").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) Unfollow(ctx context.Context, req *pb.UnfollowRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("Unfollow").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new unfollow request",
+		zap.String("follower_id", req.FollowerId),
+		zap.String("followee_id", req.FolloweeId))
+
+	follower, followee, err := uss.parseFollow(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = authorizeSelf(ctx, req.FollowerId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.Unfollow(follower, followee); err != nil {
+		return &emptypb.Empty{}, followStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("Unfollow").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) ListFollowers(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return uss.listFollows(req, "ListFollowers", uss.core.ListFollowers)
+}
+
+func (uss *UserServiceServer) ListFollowing(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return uss.listFollows(req, "ListFollowing", uss.core.ListFollowing)
+}
+
+func (uss *UserServiceServer) listFollows(
+	req *pb.ListFollowsRequest,
+	method string,
+	list func(uuid.UUID, int, string) ([]entity.User, string, error),
+) (*pb.ListFollowsResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues(method).Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new list follows request",
+		zap.String("method", method),
+		zap.String("user_id", req.UserId),
+		zap.Int32("page_size", req.PageSize))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return nil, ErrInvalidUUID
+	}
+
+	users, next, err := list(uid, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, followStatus(err)
+	}
+
+	pbusers := make([]*pb.User, len(users))
+	for i, user := range users {
+		pbusers[i] = user.ToProto()
+	}
+
+	metrics.RequestDuration.WithLabelValues(method).Observe(time.Since(then).Seconds())
+
+	return &pb.ListFollowsResponse{
+		Users:         pbusers,
+		NextPageToken: next,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// loginStatus maps login failures to statuses the gateway can tell apart:
+// ResourceExhausted while throttled, PermissionDenied for a locked account.
+// Both carry a RetryInfo detail.
+func loginStatus(err error) error {
+	switch {
+	case errors.Is(err, core.ErrInvalidCredentials), errors.Is(err, core.ErrInvalidMFACode),
+		errors.Is(err, core.ErrInvalidMFATicket):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, core.ErrEmptyFields):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var retry *core.RetryError
+	if !errors.As(err, &retry) {
+		return err
+	}
+
+	code := codes.ResourceExhausted
+	if errors.Is(err, core.ErrAccountLocked) {
+		code = codes.PermissionDenied
+	}
+
+	st, detailErr := status.New(code, retry.Error()).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retry.RetryAfter),
+	})
+	if detailErr != nil {
+		return status.Error(code, retry.Error())
+	}
+
+	return st.Err()
+}
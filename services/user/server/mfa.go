@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func mfaStatus(err error) error {
+	switch {
+	case errors.Is(err, core.ErrMFAAlreadyEnabled), errors.Is(err, core.ErrMFANotEnrolled),
+		errors.Is(err, core.ErrMFANotEnabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, core.ErrInvalidMFACode), errors.Is(err, core.ErrEmptyFields):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+func (uss *UserServiceServer) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.TokenPair, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("VerifyMFA").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new verify mfa request",
+		zap.String("ip_address", req.IpAddress))
+
+	tokens, err := uss.core.VerifyMFA(req.MfaTicket, req.Code, entity.ClientInfo{
+		UserAgent: req.UserAgent,
+		IPAddress: req.IpAddress,
+	})
+	if err != nil {
+		return nil, loginStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("VerifyMFA").Observe(time.Since(then).Seconds())
+
+	return &pb.TokenPair{
+		Refresh: tokens.RefreshToken,
+		Access:  tokens.AccessToken,
+	}, nil
+}
+
+func (uss *UserServiceServer) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("EnrollTOTP").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new enroll totp request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeSelf(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	secret, uri, recovery, err := uss.core.EnrollTOTP(uid)
+	if err != nil {
+		return nil, mfaStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("EnrollTOTP").Observe(time.Since(then).Seconds())
+
+	return &pb.EnrollTOTPResponse{
+		Secret:          secret,
+		ProvisioningUri: uri,
+		RecoveryCodes:   recovery,
+	}, nil
+}
+
+func (uss *UserServiceServer) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ConfirmTOTP").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new confirm totp request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	if err = authorizeSelf(ctx, req.UserId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.ConfirmTOTP(uid, req.Code); err != nil {
+		return &emptypb.Empty{}, mfaStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("ConfirmTOTP").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) DisableTOTP(ctx context.Context, req *pb.DisableTOTPRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("DisableTOTP").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new disable totp request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	if err = authorizeSelf(ctx, req.UserId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.DisableTOTP(uid, req.Code); err != nil {
+		return &emptypb.Empty{}, mfaStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("DisableTOTP").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
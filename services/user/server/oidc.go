@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
+	"github.com/osamikoyo/music-and-marks/services/user/metrics"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func oidcStatus(err error) error {
+	switch {
+	case errors.Is(err, core.ErrUnknownProvider), errors.Is(err, repository.ErrIdentityNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrEmptyFields), errors.Is(err, core.ErrInvalidOIDCState):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, core.ErrOIDCFailed):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, core.ErrIdentityLinked), errors.Is(err, core.ErrProviderAlreadyLinked):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, core.ErrOIDCEmailRequired), errors.Is(err, core.ErrOIDCEmailTaken),
+		errors.Is(err, core.ErrLastLoginMethod):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
+// BeginOIDC is unguarded for sign-ins; linking needs the user it links to.
+func (uss *UserServiceServer) BeginOIDC(ctx context.Context, req *pb.BeginOIDCRequest) (*pb.BeginOIDCResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("BeginOIDC").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new begin oidc request",
+		zap.String("provider", req.Provider),
+		zap.String("user_id", req.UserId))
+
+	var uid *uuid.UUID
+
+	if req.UserId != "" {
+		parsed, err := uuid.Parse(req.UserId)
+		if err != nil {
+			uss.logger.Error("failed to parse uuid from request",
+				zap.String("id", req.UserId))
+
+			return nil, ErrInvalidUUID
+		}
+
+		if err = authorizeSession(ctx, req.UserId); err != nil {
+			return nil, err
+		}
+
+		uid = &parsed
+	}
+
+	url, state, err := uss.core.BeginOIDC(req.Provider, uid)
+	if err != nil {
+		return nil, oidcStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("BeginOIDC").Observe(time.Since(then).Seconds())
+
+	return &pb.BeginOIDCResponse{
+		AuthorizationUrl: url,
+		State:            state,
+	}, nil
+}
+
+func (uss *UserServiceServer) CompleteOIDC(ctx context.Context, req *pb.CompleteOIDCRequest) (*pb.CompleteOIDCResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("CompleteOIDC").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new complete oidc request",
+		zap.String("provider", req.Provider),
+		zap.String("ip_address", req.IpAddress))
+
+	tokens, ticket, linked, err := uss.core.CompleteOIDC(req.Provider, req.State, req.Code, entity.ClientInfo{
+		UserAgent: req.UserAgent,
+		IPAddress: req.IpAddress,
+	})
+	if err != nil {
+		return nil, oidcStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("CompleteOIDC").Observe(time.Since(then).Seconds())
+
+	switch {
+	case linked != nil:
+		return &pb.CompleteOIDCResponse{
+			Linked: linked.ToProto(),
+		}, nil
+	case len(ticket) > 0:
+		return &pb.CompleteOIDCResponse{
+			MfaRequired: true,
+			MfaTicket:   ticket,
+		}, nil
+	}
+
+	return &pb.CompleteOIDCResponse{
+		Tokens: &pb.TokenPair{
+			Refresh: tokens.RefreshToken,
+			Access:  tokens.AccessToken,
+		},
+	}, nil
+}
+
+func (uss *UserServiceServer) ListIdentities(ctx context.Context, req *pb.ListIdentitiesRequest) (*pb.ListIdentitiesResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ListIdentities").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new list identities request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	identities, err := uss.core.ListIdentities(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	pbidentities := make([]*pb.Identity, len(identities))
+	for i, identity := range identities {
+		pbidentities[i] = identity.ToProto()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListIdentities").Observe(time.Since(then).Seconds())
+
+	return &pb.ListIdentitiesResponse{
+		Identities: pbidentities,
+	}, nil
+}
+
+func (uss *UserServiceServer) UnlinkIdentity(ctx context.Context, req *pb.UnlinkIdentityRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("UnlinkIdentity").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new unlink identity request",
+		zap.String("user_id", req.UserId),
+		zap.String("provider", req.Provider))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	if err = authorizeSession(ctx, req.UserId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.UnlinkIdentity(uid, req.Provider); err != nil {
+		return &emptypb.Empty{}, oidcStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("UnlinkIdentity").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"github.com/osamikoyo/music-and-marks/services/user/metrics"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func personalTokenStatus(err error) error {
+	switch {
+	case errors.Is(err, core.ErrEmptyFields), errors.Is(err, core.ErrInvalidProfile),
+		errors.Is(err, core.ErrInvalidScope), errors.Is(err, core.ErrInvalidExpiry):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, core.ErrTooManyTokens):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, core.ErrInvalidPersonalToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, repository.ErrPersonalTokenNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
+
+func (uss *UserServiceServer) CreatePersonalToken(ctx context.Context, req *pb.CreatePersonalTokenRequest) (*pb.CreatePersonalTokenResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("CreatePersonalToken").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new create personal token request",
+		zap.String("user_id", req.UserId),
+		zap.Strings("scopes", req.Scopes))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeSession(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	token, secret, err := uss.core.CreatePersonalToken(uid, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, personalTokenStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("CreatePersonalToken").Observe(time.Since(then).Seconds())
+
+	return &pb.CreatePersonalTokenResponse{
+		Token:  token.ToProto(),
+		Secret: secret,
+	}, nil
+}
+
+func (uss *UserServiceServer) ListPersonalTokens(ctx context.Context, req *pb.ListPersonalTokensRequest) (*pb.ListPersonalTokensResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ListPersonalTokens").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new list personal tokens request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeSession(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	tokens, err := uss.core.ListPersonalTokens(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	pbtokens := make([]*pb.PersonalToken, len(tokens))
+	for i, token := range tokens {
+		pbtokens[i] = token.ToProto()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListPersonalTokens").Observe(time.Since(then).Seconds())
+
+	return &pb.ListPersonalTokensResponse{
+		Tokens: pbtokens,
+	}, nil
+}
+
+func (uss *UserServiceServer) RevokePersonalToken(ctx context.Context, req *pb.RevokePersonalTokenRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("RevokePersonalToken").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new revoke personal token request",
+		zap.String("user_id", req.UserId),
+		zap.String("token_id", req.TokenId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	tid, err := uuid.Parse(req.TokenId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.TokenId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	if err = authorizeSession(ctx, req.UserId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.RevokePersonalToken(uid, tid); err != nil {
+		return &emptypb.Empty{}, personalTokenStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("RevokePersonalToken").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+// ExchangePersonalToken is called by the gateway for every personal token
+// it has not cached yet.
+func (uss *UserServiceServer) ExchangePersonalToken(ctx context.Context, req *pb.ExchangePersonalTokenRequest) (*pb.ExchangePersonalTokenResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ExchangePersonalToken").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	access, exp, err := uss.core.ExchangePersonalToken(req.Token)
+	if err != nil {
+		return nil, personalTokenStatus(err)
+	}
+
+	metrics.RequestDuration.WithLabelValues("ExchangePersonalToken").Observe(time.Since(then).Seconds())
+
+	return &pb.ExchangePersonalTokenResponse{
+		AccessToken: access,
+		ExpiresAt:   timestamppb.New(exp),
+	}, nil
+}
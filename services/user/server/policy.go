@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+
+	"github.com/osamikoyo/music-and-marks/authz"
+	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Policy lists the UserService methods that need an authenticated caller.
+// Counter methods are called by other services and stay unguarded.
+var Policy = authz.Policy{
+	pb.UserService_ChangePassword_FullMethodName: authz.Authenticated,
+	pb.UserService_DeleteUser_FullMethodName:     authz.Authenticated,
+	pb.UserService_ListSessions_FullMethodName:   authz.Authenticated,
+	pb.UserService_RevokeSession_FullMethodName:  authz.Authenticated,
+	pb.UserService_SetUserRole_FullMethodName:    authz.PermManageUsers,
+	pb.UserService_UpdateUser_FullMethodName:     authz.Authenticated,
+	pb.UserService_ChangeEmail_FullMethodName:    authz.Authenticated,
+
+	pb.UserService_SendVerificationEmail_FullMethodName: authz.Authenticated,
+	pb.UserService_EnrollTOTP_FullMethodName:            authz.Authenticated,
+	pb.UserService_ConfirmTOTP_FullMethodName:           authz.Authenticated,
+	pb.UserService_DisableTOTP_FullMethodName:           authz.Authenticated,
+
+	pb.UserService_CreatePersonalToken_FullMethodName: authz.Authenticated,
+	pb.UserService_ListPersonalTokens_FullMethodName:  authz.Authenticated,
+	pb.UserService_RevokePersonalToken_FullMethodName: authz.Authenticated,
+
+	pb.UserService_ListIdentities_FullMethodName: authz.Authenticated,
+	pb.UserService_UnlinkIdentity_FullMethodName: authz.Authenticated,
+
+	pb.UserService_Follow_FullMethodName:   authz.Authenticated,
+	pb.UserService_Unfollow_FullMethodName: authz.Authenticated,
+}
+
+// authorizeUser lets callers act on their own account and admins on any.
+func authorizeUser(ctx context.Context, target string) error {
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if claims.UserID != target && !claims.Role.Can(authz.PermManageUsers) {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return nil
+}
+
+// authorizeSelf lets callers act only on their own account, even admins:
+// second factors are personal.
+func authorizeSelf(ctx context.Context, target string) error {
+	claims, ok := authz.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if claims.UserID != target {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return nil
+}
+
+// authorizeSession is authorizeSelf for callers signed in with a session:
+// a personal access token must not manage tokens or sign-in methods.
+func authorizeSession(ctx context.Context, target string) error {
+	if err := authorizeSelf(ctx, target); err != nil {
+		return err
+	}
+
+	if claims, _ := authz.FromContext(ctx); claims.Personal {
+		return status.Error(codes.PermissionDenied, "not allowed with a personal access token")
+	}
+
+	return nil
+}
@@ -3,14 +3,20 @@ package server
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/osamikoyo/music-and-marks/authz"
 	"github.com/osamikoyo/music-and-marks/logger"
 	"github.com/osamikoyo/music-and-marks/services/user/api/proto/gen/pb"
 	"github.com/osamikoyo/music-and-marks/services/user/core"
+	"github.com/osamikoyo/music-and-marks/services/user/entity"
 	"github.com/osamikoyo/music-and-marks/services/user/metrics"
+	"github.com/osamikoyo/music-and-marks/services/user/repository"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -55,6 +61,10 @@ func (uss *UserServiceServer) ChangePassword(ctx context.Context, req *pb.Change
 		return &emptypb.Empty{}, ErrInvalidUUID
 	}
 
+	if err = authorizeUser(ctx, req.Id); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
 	if err = uss.core.ChangePassword(uid, req.CurrentPassword, req.NewPassword); err != nil {
 		return &emptypb.Empty{}, err
 	}
@@ -84,6 +94,10 @@ func (uss *UserServiceServer) DeleteUser(ctx context.Context, req *pb.DeleteUser
 		return &emptypb.Empty{}, ErrInvalidUUID
 	}
 
+	if err = authorizeUser(ctx, req.Id); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
 	if err := uss.core.DeleteUser(uid); err != nil {
 		return &emptypb.Empty{}, err
 	}
@@ -124,7 +138,7 @@ func (uss *UserServiceServer) GetUser(ctx context.Context, req *pb.GetUserReques
 	return user.ToProto(), nil
 }
 
-func (uss *UserServiceServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.TokenPair, error) {
+func (uss *UserServiceServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
 	then := time.Now()
 	metrics.RequestTotal.WithLabelValues("Login").Inc()
 
@@ -138,16 +152,28 @@ func (uss *UserServiceServer) Login(ctx context.Context, req *pb.LoginRequest) (
 		zap.String("email", req.Email),
 		zap.String("password", req.Password))
 
-	tokens, err := uss.core.LoginUser(req.Password, req.Email)
+	tokens, ticket, err := uss.core.LoginUser(req.Password, req.Email, entity.ClientInfo{
+		UserAgent: req.UserAgent,
+		IPAddress: req.IpAddress,
+	})
 	if err != nil {
-		return nil, err
+		return nil, loginStatus(err)
 	}
 
 	metrics.RequestDuration.WithLabelValues("Login").Observe(float64(time.Since(then).Seconds()))
 
-	return &pb.TokenPair{
-		Refresh: tokens.RefreshToken,
-		Access:  tokens.AccessToken,
+	if len(ticket) > 0 {
+		return &pb.LoginResponse{
+			MfaRequired: true,
+			MfaTicket:   ticket,
+		}, nil
+	}
+
+	return &pb.LoginResponse{
+		Tokens: &pb.TokenPair{
+			Refresh: tokens.RefreshToken,
+			Access:  tokens.AccessToken,
+		},
 	}, nil
 }
 
@@ -164,18 +190,129 @@ func (uss *UserServiceServer) RefreshToken(ctx context.Context, req *pb.RefreshT
 	uss.logger.Info("new refresh token request",
 		zap.String("refresh_token", req.RefreshToken))
 
-	accessToken, err := uss.core.Refresh(req.RefreshToken)
+	tokens, err := uss.core.Refresh(req.RefreshToken)
 	if err != nil {
+		if errors.Is(err, core.ErrTokenReused) {
+			uss.logger.Warn("refresh token reuse detected, session revoked")
+		}
+
 		return nil, err
 	}
 
 	metrics.RequestDuration.WithLabelValues("RefreshToken").Observe(float64(time.Since(then).Seconds()))
 
 	return &pb.RefreshTokenResponse{
-		AccessToken: accessToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
+func (uss *UserServiceServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("Logout").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new logout request")
+
+	if err := uss.core.Logout(req.RefreshToken); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("Logout").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ListSessions").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new list sessions request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	sessions, err := uss.core.ListSessions(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	pbsessions := make([]*pb.Session, len(sessions))
+	for i, session := range sessions {
+		pbsessions[i] = session.ToProto()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListSessions").Observe(time.Since(then).Seconds())
+
+	return &pb.ListSessionsResponse{
+		Sessions: pbsessions,
+	}, nil
+}
+
+func (uss *UserServiceServer) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("RevokeSession").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new revoke session request",
+		zap.String("user_id", req.UserId),
+		zap.String("session_id", req.SessionId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	sid, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.SessionId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	if err = authorizeUser(ctx, req.UserId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = uss.core.RevokeSession(uid, sid); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("RevokeSession").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
 func (uss *UserServiceServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.TokenPair, error) {
 	then := time.Now()
 	metrics.RequestTotal.WithLabelValues("Register").Inc()
@@ -191,8 +328,15 @@ func (uss *UserServiceServer) Register(ctx context.Context, req *pb.RegisterRequ
 		zap.String("password", req.Password),
 		zap.String("username", req.Username))
 
-	tokens, err := uss.core.RegisterUser(req.Username, req.Password, req.Email)
+	tokens, err := uss.core.RegisterUser(req.Username, req.Password, req.Email, entity.ClientInfo{
+		UserAgent: req.UserAgent,
+		IPAddress: req.IpAddress,
+	})
 	if err != nil {
+		if errors.Is(err, core.ErrEmptyFields) || errors.Is(err, core.ErrInvalidEmail) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, err
 	}
 
@@ -204,6 +348,16 @@ func (uss *UserServiceServer) Register(ctx context.Context, req *pb.RegisterRequ
 	}, nil
 }
 
+// counterStatus reports unknown users as NotFound so that callers can tell
+// them from failures.
+func counterStatus(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
+
 func (uss *UserServiceServer) IncLike(ctx context.Context, req *pb.IncLikeRequest) (*emptypb.Empty, error) {
 	if req == nil {
 		uss.logger.Error("empty request")
@@ -223,7 +377,7 @@ func (uss *UserServiceServer) IncLike(ctx context.Context, req *pb.IncLikeReques
 	}
 
 	if err = uss.core.IncLike(uid); err != nil {
-		return &emptypb.Empty{}, err
+		return &emptypb.Empty{}, counterStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -248,7 +402,7 @@ func (uss *UserServiceServer) DecLike(ctx context.Context, req *pb.DecLikeReques
 	}
 
 	if err = uss.core.DecLike(uid); err != nil {
-		return &emptypb.Empty{}, err
+		return &emptypb.Empty{}, counterStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -273,7 +427,7 @@ func (uss *UserServiceServer) IncReview(ctx context.Context, req *pb.IncReviewRe
 	}
 
 	if err = uss.core.IncReview(uid); err != nil {
-		return &emptypb.Empty{}, err
+		return &emptypb.Empty{}, counterStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -298,8 +452,320 @@ func (uss *UserServiceServer) DecReview(ctx context.Context, req *pb.DecReviewRe
 	}
 
 	if err = uss.core.DecReview(uid); err != nil {
+		return &emptypb.Empty{}, counterStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.User, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("SetUserRole").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new set user role request",
+		zap.String("id", req.Id),
+		zap.String("role", req.Role))
+
+	uid, err := uuid.Parse(req.Id)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.Id))
+
+		return nil, ErrInvalidUUID
+	}
+
+	user, err := uss.core.SetUserRole(uid, req.Role)
+	if err != nil {
+		if errors.Is(err, authz.ErrUnknownRole) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("SetUserRole").Observe(time.Since(then).Seconds())
+
+	return user.ToProto(), nil
+}
+
+func (uss *UserServiceServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("UpdateUser").Inc()
+
+	if req == nil || req.User == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new update user request",
+		zap.String("id", req.Id),
+		zap.Strings("mask", req.UpdateMask.GetPaths()))
+
+	uid, err := uuid.Parse(req.Id)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.Id))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeUser(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	// the email receives password resets, so users change their own with
+	// the current password; admins may still correct it for others
+	if slices.Contains(req.UpdateMask.GetPaths(), "email") {
+		if claims, ok := authz.FromContext(ctx); ok && claims.UserID == req.Id {
+			return nil, status.Error(codes.InvalidArgument, "change the email with the current password")
+		}
+	}
+
+	patch := &entity.User{
+		Username:    req.User.Username,
+		Email:       req.User.Email,
+		DisplayName: req.User.DisplayName,
+		Bio:         req.User.Bio,
+		AvatarURL:   req.User.AvatarUrl,
+	}
+
+	user, err := uss.core.UpdateUser(uid, patch, req.UpdateMask.GetPaths())
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrUsernameTaken), errors.Is(err, core.ErrEmailTaken):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, core.ErrUnknownField), errors.Is(err, core.ErrInvalidProfile), errors.Is(err, core.ErrEmptyFields),
+			errors.Is(err, core.ErrInvalidEmail):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("UpdateUser").Observe(time.Since(then).Seconds())
+
+	return user.ToProto(), nil
+}
+
+func (uss *UserServiceServer) ChangeEmail(ctx context.Context, req *pb.ChangeEmailRequest) (*pb.User, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ChangeEmail").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new change email request",
+		zap.String("id", req.Id))
+
+	uid, err := uuid.Parse(req.Id)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.Id))
+
+		return nil, ErrInvalidUUID
+	}
+
+	if err = authorizeSelf(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	user, err := uss.core.ChangeEmail(uid, req.CurrentPassword, req.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, core.ErrEmailTaken):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, core.ErrWrongPassword):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, core.ErrEmptyFields), errors.Is(err, core.ErrInvalidEmail):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("ChangeEmail").Observe(time.Since(then).Seconds())
+
+	return user.ToProto(), nil
+}
+
+func (uss *UserServiceServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ListUsers").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new list users request",
+		zap.String("username_prefix", req.UsernamePrefix),
+		zap.String("order_by", req.OrderBy),
+		zap.Int32("page_size", req.PageSize))
+
+	order, desc, err := core.ParseUserOrder(req.OrderBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := entity.UserFilter{
+		UsernamePrefix: req.UsernamePrefix,
+		OrderBy:        order,
+		Desc:           desc,
+	}
+
+	users, next, total, err := uss.core.ListUsers(filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		if errors.Is(err, core.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	pbusers := make([]*pb.User, len(users))
+	for i, user := range users {
+		pbusers[i] = user.ToProto()
+	}
+
+	metrics.RequestDuration.WithLabelValues("ListUsers").Observe(time.Since(then).Seconds())
+
+	return &pb.ListUsersResponse{
+		Users:         pbusers,
+		NextPageToken: next,
+		TotalSize:     int32(total),
+	}, nil
+}
+
+func (uss *UserServiceServer) SendVerificationEmail(ctx context.Context, req *pb.SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("SendVerificationEmail").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new send verification email request",
+		zap.String("user_id", req.UserId))
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		uss.logger.Error("failed to parse uuid from request",
+			zap.String("id", req.UserId))
+
+		return &emptypb.Empty{}, ErrInvalidUUID
+	}
+
+	if err = authorizeUser(ctx, req.UserId); err != nil {
 		return &emptypb.Empty{}, err
 	}
 
+	if err = uss.core.SendVerificationEmail(uid); err != nil {
+		switch {
+		case errors.Is(err, core.ErrAlreadyVerified):
+			return &emptypb.Empty{}, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, core.ErrEmptyFields):
+			return &emptypb.Empty{}, status.Error(codes.FailedPrecondition, "account has no email")
+		case errors.Is(err, core.ErrMailFailed):
+			return &emptypb.Empty{}, status.Error(codes.Unavailable, err.Error())
+		}
+
+		return &emptypb.Empty{}, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("SendVerificationEmail").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.User, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("VerifyEmail").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return nil, ErrEmptyReq
+	}
+
+	uss.logger.Info("new verify email request")
+
+	user, err := uss.core.VerifyEmail(req.Token)
+	if err != nil {
+		if errors.Is(err, core.ErrEmptyFields) || errors.Is(err, repository.ErrActionTokenNotFound) {
+			return nil, status.Error(codes.InvalidArgument, repository.ErrActionTokenNotFound.Error())
+		}
+
+		return nil, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("VerifyEmail").Observe(time.Since(then).Seconds())
+
+	return user.ToProto(), nil
+}
+
+func (uss *UserServiceServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("RequestPasswordReset").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new request password reset request",
+		zap.String("email", req.Email))
+
+	if err := uss.core.RequestPasswordReset(req.Email); err != nil {
+		if errors.Is(err, core.ErrEmptyFields) {
+			return &emptypb.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &emptypb.Empty{}, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("RequestPasswordReset").Observe(time.Since(then).Seconds())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (uss *UserServiceServer) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	then := time.Now()
+	metrics.RequestTotal.WithLabelValues("ResetPassword").Inc()
+
+	if req == nil {
+		uss.logger.Error("empty request")
+
+		return &emptypb.Empty{}, ErrEmptyReq
+	}
+
+	uss.logger.Info("new reset password request")
+
+	if err := uss.core.ResetPassword(req.Token, req.NewPassword); err != nil {
+		switch {
+		case errors.Is(err, core.ErrEmptyFields):
+			return &emptypb.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, repository.ErrActionTokenNotFound):
+			return &emptypb.Empty{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &emptypb.Empty{}, err
+	}
+
+	metrics.RequestDuration.WithLabelValues("ResetPassword").Observe(time.Since(then).Seconds())
+
 	return &emptypb.Empty{}, nil
 }